@@ -7,6 +7,7 @@ import (
 	"time"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -19,6 +20,10 @@ type ServiceAttributeDefinition interface {
 	// Register add the attribute to the resource schema.
 	Register(d *schema.Resource) error
 
+	// GetKey returns the name of the attribute's block, used to add context to
+	// errors surfaced from Read/Process.
+	GetKey() string
+
 	// Read refreshes the attribute state against the Fastly API.
 	Read(d *schema.ResourceData, s *gofastly.ServiceDetail, conn *gofastly.Client) error
 
@@ -90,6 +95,13 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(
+			validateBackendHealthCheckReferences,
+			validateBackendSSLSettings,
+			warnCacheSettingTTL,
+			warnResponseObjectSharedConditions,
+			validateSyslogTLSClientPairs,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -98,10 +110,13 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Description: "Unique name for this Service",
 			},
 
+			// comment is Computed rather than defaulted so that a config which
+			// omits it does not fight with edits made in the Fastly UI. The
+			// "Managed by Terraform" default is only applied on create.
 			"comment": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "Managed by Terraform",
+				Computed:    true,
 				Description: "A personal freeform descriptive note",
 			},
 
@@ -111,6 +126,22 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Description: "A personal freeform descriptive note",
 			},
 
+			// version_comment is the desired comment for the next version,
+			// so the comment on the live version is exposed separately.
+			"active_version_comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The comment on the currently active version",
+			},
+
+			// Activating a version is the last write the API makes to it, so its
+			// updated_at timestamp records when the current config went live.
+			"active_version_deployed_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the currently active version was deployed",
+			},
+
 			// Active Version represents the currently activated version in Fastly. In
 			// Terraform, we abstract this number away from the users and manage
 			// creating and activating. It's used internally, but also exported for
@@ -138,10 +169,39 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 				Optional:    true,
 			},
 
+			// Cloned versions are not immediately available in the Fastly API, so
+			// the provider polls until the clone is ready. These knobs exist for
+			// users whose clones routinely take longer than the defaults allow.
+			"version_clone_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "30s",
+				Description:  "How long to wait for a cloned version to become available before giving up",
+				ValidateFunc: validateDuration(),
+			},
+
+			"version_clone_poll_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2s",
+				Description:  "How often to poll for a cloned version to become available",
+				ValidateFunc: validateDuration(),
+			},
+
 			"force_destroy": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+
+			// A read-only report of which blocks reference each condition,
+			// assembled during Read. Useful for working out what still uses a
+			// condition before removing it.
+			"condition_usage": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of condition name to a comma-separated list of blockType/name strings that reference it",
+			},
 		},
 	}
 
@@ -155,6 +215,19 @@ func resourceService(serviceDef ServiceDefinition) *schema.Resource {
 	return s
 }
 
+// customizeDiffAll runs each CustomizeDiff function in order, stopping at the
+// first error.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		for _, f := range funcs {
+			if err := f(d, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 // resourceCreate satisfies the Terraform resource schema Create "interface"
 // while injecting the ServiceDefinition into the true Create functionality.
 func resourceCreate(serviceDef ServiceDefinition) schema.CreateFunc {
@@ -187,6 +260,17 @@ func resourceDelete(serviceDef ServiceDefinition) schema.DeleteFunc {
 	}
 }
 
+// createServiceComment returns the comment to send on service creation.
+// An omitted comment gets the historical "Managed by Terraform" default;
+// after create the attribute is Computed, so an omitted comment no longer
+// overwrites edits made outside of Terraform on every apply.
+func createServiceComment(comment string) string {
+	if comment == "" {
+		return "Managed by Terraform"
+	}
+	return comment
+}
+
 // resourceServiceCreate provides service resource Create functionality.
 func resourceServiceCreate(d *schema.ResourceData, meta interface{}, serviceDef ServiceDefinition) error {
 	if err := validateVCLs(d); err != nil {
@@ -196,7 +280,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}, serviceDef
 	conn := meta.(*FastlyClient).conn
 	service, err := conn.CreateService(&gofastly.CreateServiceInput{
 		Name:    d.Get("name").(string),
-		Comment: d.Get("comment").(string),
+		Comment: createServiceComment(d.Get("comment").(string)),
 		Type:    serviceDef.GetType(),
 	})
 
@@ -208,6 +292,66 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}, serviceDef
 	return resourceServiceUpdate(d, meta, serviceDef)
 }
 
+// errServiceDeletedOutOfBand inspects an Update-path error for a 404,
+// which means the service was deleted (e.g. in the console) between plan
+// and apply. The resource ID is cleared the way Read does for
+// fastlyNoServiceFoundErr, so a re-plan recreates the service instead of
+// failing on the stale ID again.
+func errServiceDeletedOutOfBand(d *schema.ResourceData, err error) error {
+	if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.IsNotFound() {
+		id := d.Id()
+		d.SetId("")
+		return fmt.Errorf("[WARN] Service (%s) was deleted outside of Terraform during apply. It has been removed from state; run plan again to recreate it", id)
+	}
+	return err
+}
+
+// activeVersionDeployedAt formats the deployment timestamp of the active
+// version. The API has no explicit deployed_at field, but activation is the
+// last write made to a version, so its updated_at records when it went live.
+// Returns "" when there is no active version.
+func activeVersionDeployedAt(v *gofastly.Version) string {
+	if v.Number == 0 || v.UpdatedAt == nil {
+		return ""
+	}
+	return v.UpdatedAt.Format(time.RFC3339)
+}
+
+// waitForVersionClone polls GetVersion until the freshly cloned version is
+// visible in the API and unlocked, or the timeout elapses. Clones are not
+// immediately available, so proceeding straight away results in 404s.
+func waitForVersionClone(conn *gofastly.Client, service string, version int, timeout, interval time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"ready"},
+		Refresh: func() (interface{}, string, error) {
+			v, err := conn.GetVersion(&gofastly.GetVersionInput{
+				Service: service,
+				Version: version,
+			})
+			if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.IsNotFound() {
+				// Not visible yet, keep polling.
+				return nil, "pending", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			if v.Locked {
+				return v, "pending", nil
+			}
+			return v, "ready", nil
+		},
+		Timeout:      timeout,
+		PollInterval: interval,
+	}
+
+	log.Printf("[DEBUG] Waiting for Fastly Version (%d) on service (%s) to be available", version, service)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("[ERR] Error waiting for Fastly Version (%d) on service (%s) to be available: %s", version, service, err)
+	}
+	return nil
+}
+
 // resourceServiceUpdate provides service resource Update functionality.
 func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef ServiceDefinition) error {
 	if err := validateVCLs(d); err != nil {
@@ -224,7 +368,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 			Comment: d.Get("comment").(string),
 		})
 		if err != nil {
-			return err
+			return errServiceDeletedOutOfBand(d, err)
 		}
 	}
 
@@ -280,7 +424,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 				Version: latestVersion,
 			})
 			if err != nil {
-				return err
+				return errServiceDeletedOutOfBand(d, err)
 			}
 
 			// The new version number is named "Number", but it's actually a string.
@@ -288,10 +432,13 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 			d.Set("cloned_version", latestVersion)
 
 			// New versions are not immediately found in the API, or are not
-			// immediately mutable, so we need to sleep a few and let Fastly ready
-			// itself. Typically, 7 seconds is enough.
-			log.Print("[DEBUG] Sleeping 7 seconds to allow Fastly Version to be available")
-			time.Sleep(7 * time.Second)
+			// immediately mutable, so poll until Fastly reports the clone as
+			// available instead of sleeping a fixed amount.
+			timeout, _ := time.ParseDuration(d.Get("version_clone_timeout").(string))
+			interval, _ := time.ParseDuration(d.Get("version_clone_poll_interval").(string))
+			if err := waitForVersionClone(conn, d.Id(), latestVersion, timeout, interval); err != nil {
+				return err
+			}
 
 			// Update the cloned version's comment.
 			if d.Get("version_comment").(string) != "" {
@@ -314,7 +461,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}, serviceDef
 		for _, a := range serviceDef.GetAttributeHandler() {
 			if a.MustProcess(d, initialVersion) {
 				if err := a.Process(d, latestVersion, conn); err != nil {
-					return err
+					return fmt.Errorf("error processing %s block: %s", a.GetKey(), err)
 				}
 			}
 		}
@@ -389,6 +536,19 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}, serviceDef Se
 	d.Set("comment", s.Comment)
 	d.Set("version_comment", s.Version.Comment)
 	d.Set("active_version", s.ActiveVersion.Number)
+	d.Set("active_version_comment", s.ActiveVersion.Comment)
+	d.Set("active_version_deployed_at", activeVersionDeployedAt(&s.ActiveVersion))
+
+	// With activate = false the service may have no active version at all, only
+	// the draft created by the last apply. Refresh against the latest version in
+	// that case so computed attributes (acl_id, dictionary_id, snippet_id, ...)
+	// are not wiped from state. The attribute handlers read the version off
+	// ServiceDetail, so substitute it before delegating.
+	if s.ActiveVersion.Number == 0 && s.Version.Number != 0 {
+		log.Printf("[DEBUG] No active version for Service (%s), refreshing from latest version (%d)", d.Id(), s.Version.Number)
+		s.ActiveVersion = s.Version
+		s.ActiveVersion.Comment = ""
+	}
 
 	// If CreateService succeeds, but initial updates to the Service fail, we'll
 	// have an empty ActiveService version (no version is active, so we can't
@@ -403,6 +563,10 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}, serviceDef Se
 			}
 		}
 
+		if err := readConditionUsage(d); err != nil {
+			log.Printf("[WARN] Error setting condition usage for (%s): %s", d.Id(), err)
+		}
+
 	} else {
 		log.Printf("[DEBUG] Active Version for Service (%s) is empty, no state to refresh", d.Id())
 	}
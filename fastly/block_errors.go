@@ -0,0 +1,15 @@
+package fastly
+
+import (
+	"fmt"
+)
+
+// wrapBlockError adds block type, element name and operation context to an
+// API error, so a failure inside a large set points at the offending element
+// rather than just returning the bare HTTP status.
+func wrapBlockError(blockKey, name, operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s %q: %s: %s", blockKey, name, operation, err)
+}
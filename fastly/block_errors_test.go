@@ -0,0 +1,18 @@
+package fastly
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWrapBlockError(t *testing.T) {
+	if err := wrapBlockError("header", "strip-cookies", "CreateHeader", nil); err != nil {
+		t.Fatalf("Expected nil for nil error, got %s", err)
+	}
+
+	err := wrapBlockError("header", "strip-cookies", "CreateHeader", errors.New("400 Bad Request"))
+	expected := `header "strip-cookies": CreateHeader: 400 Bad Request`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected %q, got %q", expected, err)
+	}
+}
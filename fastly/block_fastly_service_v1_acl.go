@@ -35,9 +35,24 @@ func (h *ACLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 	remove := oldACLSet.Difference(newACLSet).List()
 	add := newACLSet.Difference(oldACLSet).List()
 
+	// A refresh fills in the computed acl_id and force_destroy only exists in
+	// configuration; either changing alone would delete and recreate the ACL
+	// — discarding its entries — without any real change, so skip elements
+	// that only differ there.
+	remove, add = pruneSemanticallyUnchangedElements(remove, add, "acl_id", "force_destroy")
+
 	// Delete removed ACL configurations
 	for _, vRaw := range remove {
 		val := vRaw.(map[string]interface{})
+
+		// The API refuses to delete an ACL that still has entries, so clear
+		// them out first when the block opts in via force_destroy.
+		if force, _ := val["force_destroy"].(bool); force {
+			if err := deleteACLEntries(conn, d.Id(), val["acl_id"].(string)); err != nil {
+				return wrapBlockError(h.GetKey(), val["name"].(string), "DeleteACLEntry", err)
+			}
+		}
+
 		opts := gofastly.DeleteACLInput{
 			Service: d.Id(),
 			Version: latestVersion,
@@ -49,10 +64,10 @@ func (h *ACLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteACL", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteACL", err)
 		}
 	}
 
@@ -68,7 +83,7 @@ func (h *ACLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 		log.Printf("[DEBUG] Fastly ACL creation opts: %#v", opts)
 		_, err := conn.CreateACL(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateACL", err)
 		}
 	}
 	return nil
@@ -87,6 +102,23 @@ func (h *ACLServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.Se
 
 	al := flattenACLs(aclList)
 
+	// force_destroy only exists in the configuration, so carry it over from
+	// the prior state; otherwise a refresh would reset the flag and churn the
+	// set hash.
+	if prior, ok := d.GetOk(h.GetKey()); ok {
+		flags := make(map[string]bool)
+		for _, aRaw := range prior.(*schema.Set).List() {
+			af := aRaw.(map[string]interface{})
+			flags[af["name"].(string)], _ = af["force_destroy"].(bool)
+		}
+
+		for _, na := range al {
+			if v, ok := flags[na["name"].(string)]; ok {
+				na["force_destroy"] = v
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), al); err != nil {
 		log.Printf("[WARN] Error setting ACLs for (%s): %s", d.Id(), err)
 	}
@@ -94,6 +126,31 @@ func (h *ACLServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastly.Se
 	return nil
 }
 
+// deleteACLEntries removes every entry from an ACL so that the ACL itself can
+// be deleted.
+func deleteACLEntries(conn *gofastly.Client, serviceID, aclID string) error {
+	entries, err := conn.ListACLEntries(&gofastly.ListACLEntriesInput{
+		Service: serviceID,
+		ACL:     aclID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		log.Printf("[DEBUG] Fastly ACL entry removal for ACL (%s): %s", aclID, entry.ID)
+		if err := conn.DeleteACLEntry(&gofastly.DeleteACLEntryInput{
+			Service: serviceID,
+			ACL:     aclID,
+			ID:      entry.ID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (h *ACLServiceAttributeHandler) Register(s *schema.Resource) error {
 	s.Schema[h.GetKey()] = &schema.Schema{
 		Type:     schema.TypeSet,
@@ -112,6 +169,12 @@ func (h *ACLServiceAttributeHandler) Register(s *schema.Resource) error {
 					Computed:    true,
 					Description: "Generated acl id",
 				},
+				"force_destroy": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Delete any remaining entries in the ACL before removing it. Without this, removing an ACL that still has entries fails",
+				},
 			},
 		},
 	}
@@ -125,6 +188,8 @@ func flattenACLs(aclList []*gofastly.ACL) []map[string]interface{} {
 		vclMap := map[string]interface{}{
 			"acl_id": acl.ID,
 			"name":   acl.Name,
+			// Config-only flag; Read carries the real value over from state.
+			"force_destroy": false,
 		}
 
 		// prune any empty values that come from the default string value in structs
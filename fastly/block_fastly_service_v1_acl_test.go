@@ -25,8 +25,9 @@ func TestResourceFastlyFlattenAcl(t *testing.T) {
 			},
 			local: []map[string]interface{}{
 				{
-					"acl_id": "1234567890",
-					"name":   "acl-example",
+					"acl_id":        "1234567890",
+					"name":          "acl-example",
+					"force_destroy": false,
 				},
 			},
 		},
@@ -112,3 +113,107 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, domainName, backendName, aclName)
 }
+
+func TestAccFastlyServiceV1_acl_forceDestroy(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	aclName := fmt.Sprintf("acl %s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_aclForceDestroyWithEntries(name, aclName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_acl(&service, name, aclName),
+				),
+			},
+
+			// Enabling the flag on an existing ACL that has entries must be
+			// a state-only change, not a delete/recreate of the ACL.
+			{
+				Config: testAccServiceV1Config_aclForceDestroyWithEntries(name, aclName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_acl(&service, name, aclName),
+				),
+			},
+
+			{
+				Config: testAccServiceV1Config_aclForceDestroyRemoved(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "acl.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceV1Config_aclForceDestroyWithEntries(name, aclName string, force bool) string {
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+variable "myacl_name" {
+	type = string
+	default = "%s"
+}
+
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "tf-test-backend"
+  }
+
+  acl {
+    name          = var.myacl_name
+    force_destroy = %t
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_service_acl_entries_v1" "entries" {
+  service_id = fastly_service_v1.foo.id
+  acl_id     = {for s in fastly_service_v1.foo.acl : s.name => s.acl_id}[var.myacl_name]
+
+  entry {
+    ip      = "127.0.0.1"
+    subnet  = "24"
+    negated = false
+    comment = "tf-test-entry"
+  }
+}`, aclName, name, domainName, force)
+}
+
+func testAccServiceV1Config_aclForceDestroyRemoved(name string) string {
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "tf-test-backend"
+  }
+
+  force_destroy = true
+}`, name, domainName)
+}
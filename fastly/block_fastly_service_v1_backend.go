@@ -35,9 +35,27 @@ func (h *BackendServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 	removeBackends := obs.Difference(nbs).List()
 	addBackends := nbs.Difference(obs).List()
 
-	// DELETE old Backends
+	// Backends that appear in both the remove and add lists under the same
+	// name have been modified in place. Issue a PUT for those rather than a
+	// delete/create pair, which would momentarily drop the backend from the
+	// version (and reset its hand-off state, e.g. director membership).
+	existingNames := make(map[string]bool)
+	for _, bRaw := range removeBackends {
+		existingNames[bRaw.(map[string]interface{})["name"].(string)] = true
+	}
+	addedNames := make(map[string]bool)
+	for _, bRaw := range addBackends {
+		addedNames[bRaw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	// DELETE old Backends, unless they are being updated in place
 	for _, bRaw := range removeBackends {
 		bf := bRaw.(map[string]interface{})
+
+		if addedNames[bf["name"].(string)] {
+			continue
+		}
+
 		opts := gofastly.DeleteBackendInput{
 			Service: d.Id(),
 			Version: latestVersion,
@@ -48,16 +66,56 @@ func (h *BackendServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 		err := conn.DeleteBackend(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteBackend", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteBackend", err)
 		}
 	}
 
-	// Find and post new Backends
+	// Find and post new Backends, or PUT updates to modified ones
 	for _, dRaw := range addBackends {
 		df := dRaw.(map[string]interface{})
+
+		if existingNames[df["name"].(string)] {
+			opts := gofastly.UpdateBackendInput{
+				Service:             d.Id(),
+				Version:             latestVersion,
+				Name:                df["name"].(string),
+				Address:             df["address"].(string),
+				OverrideHost:        df["override_host"].(string),
+				AutoLoadbalance:     gofastly.CBool(df["auto_loadbalance"].(bool)),
+				SSLCheckCert:        gofastly.CBool(df["ssl_check_cert"].(bool)),
+				SSLHostname:         df["ssl_hostname"].(string),
+				SSLCACert:           df["ssl_ca_cert"].(string),
+				SSLCertHostname:     df["ssl_cert_hostname"].(string),
+				SSLSNIHostname:      df["ssl_sni_hostname"].(string),
+				UseSSL:              gofastly.CBool(df["use_ssl"].(bool)),
+				SSLClientKey:        df["ssl_client_key"].(string),
+				SSLClientCert:       df["ssl_client_cert"].(string),
+				MaxTLSVersion:       df["max_tls_version"].(string),
+				MinTLSVersion:       df["min_tls_version"].(string),
+				SSLCiphers:          strings.Split(df["ssl_ciphers"].(string), ","),
+				Shield:              df["shield"].(string),
+				Port:                uint(df["port"].(int)),
+				BetweenBytesTimeout: uint(df["between_bytes_timeout"].(int)),
+				ConnectTimeout:      uint(df["connect_timeout"].(int)),
+				ErrorThreshold:      uint(df["error_threshold"].(int)),
+				FirstByteTimeout:    uint(df["first_byte_timeout"].(int)),
+				MaxConn:             uint(df["max_conn"].(int)),
+				Weight:              uint(df["weight"].(int)),
+				RequestCondition:    df["request_condition"].(string),
+				HealthCheck:         df["healthcheck"].(string),
+			}
+
+			log.Printf("[DEBUG] Update Backend Opts: %#v", opts)
+			_, err := conn.UpdateBackend(&opts)
+			if err != nil {
+				return wrapBlockError(h.GetKey(), opts.Name, "UpdateBackend", err)
+			}
+			continue
+		}
+
 		opts := gofastly.CreateBackendInput{
 			Service:             d.Id(),
 			Version:             latestVersion,
@@ -91,7 +149,7 @@ func (h *BackendServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 		log.Printf("[DEBUG] Create Backend Opts: %#v", opts)
 		_, err := conn.CreateBackend(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateBackend", err)
 		}
 	}
 	return nil
@@ -110,6 +168,23 @@ func (h *BackendServiceAttributeHandler) Read(d *schema.ResourceData, s *gofastl
 
 	bl := flattenBackends(backendList)
 
+	// ignore_ssl_port_mismatch only exists in the configuration, so carry it
+	// over from the prior state; otherwise a refresh would reset the flag and
+	// churn the set hash.
+	if prior, ok := d.GetOk(h.GetKey()); ok {
+		flags := make(map[string]bool)
+		for _, bRaw := range prior.(*schema.Set).List() {
+			bf := bRaw.(map[string]interface{})
+			flags[bf["name"].(string)], _ = bf["ignore_ssl_port_mismatch"].(bool)
+		}
+
+		for _, nb := range bl {
+			if v, ok := flags[nb["name"].(string)]; ok {
+				nb["ignore_ssl_port_mismatch"] = v
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), bl); err != nil {
 		log.Printf("[WARN] Error setting Backends for (%s): %s", d.Id(), err)
 	}
@@ -275,12 +350,110 @@ func (h *BackendServiceAttributeHandler) Register(s *schema.Resource) error {
 					Default:     100,
 					Description: "The portion of traffic to send to a specific origins. Each origin receives weight/total of the traffic.",
 				},
+				"ignore_ssl_port_mismatch": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Suppress the plan-time warning when port and use_ssl disagree (e.g. port 443 with use_ssl disabled)",
+				},
 			},
 		},
 	}
 	return nil
 }
 
+// validateBackendHealthCheckReferences checks, at plan time, that every
+// backend.healthcheck reference resolves to a healthcheck block defined on
+// the same service. The API only rejects a dangling reference at apply time,
+// after a version has already been cloned.
+//
+// The check is skipped when the config defines no healthcheck blocks at all,
+// since a backend may legitimately reference a healthcheck created outside
+// Terraform on an adopted service.
+func validateBackendHealthCheckReferences(d *schema.ResourceDiff, _ interface{}) error {
+	backends, ok := d.GetOk("backend")
+	if !ok {
+		return nil
+	}
+
+	healthChecks, ok := d.GetOk("healthcheck")
+	if !ok {
+		return nil
+	}
+
+	return checkBackendHealthCheckReferences(backends.(*schema.Set).List(), healthChecks.(*schema.Set).List())
+}
+
+func checkBackendHealthCheckReferences(backends, healthChecks []interface{}) error {
+	names := make(map[string]bool, len(healthChecks))
+	for _, hRaw := range healthChecks {
+		names[hRaw.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for _, bRaw := range backends {
+		bf := bRaw.(map[string]interface{})
+		ref, _ := bf["healthcheck"].(string)
+		if ref != "" && !names[ref] {
+			return fmt.Errorf("backend '%s' references healthcheck '%s' which is not defined in the 'healthcheck' block of this service", bf["name"], ref)
+		}
+	}
+
+	return nil
+}
+
+// validateBackendSSLSettings checks, at plan time, that each backend's port
+// and SSL settings agree. A port 443 backend without use_ssl (or port 80 with
+// it) is almost always a mistake that otherwise only shows up as 502s in
+// production, so it is logged as a warning, suppressible per backend via
+// ignore_ssl_port_mismatch. Setting ssl_cert_hostname or ssl_sni_hostname
+// while use_ssl is false has no effect and is rejected outright.
+func validateBackendSSLSettings(d *schema.ResourceDiff, _ interface{}) error {
+	backends, ok := d.GetOk("backend")
+	if !ok {
+		return nil
+	}
+
+	warnings, err := checkBackendSSLConsistency(backends.(*schema.Set).List())
+	for _, w := range warnings {
+		log.Printf("[WARN] %s", w)
+	}
+
+	return err
+}
+
+func checkBackendSSLConsistency(backends []interface{}) ([]string, error) {
+	var warnings []string
+	for _, bRaw := range backends {
+		bf := bRaw.(map[string]interface{})
+		name := bf["name"].(string)
+		useSSL, _ := bf["use_ssl"].(bool)
+		port, _ := bf["port"].(int)
+		ignoreMismatch, _ := bf["ignore_ssl_port_mismatch"].(bool)
+
+		if !useSSL {
+			if certHostname, _ := bf["ssl_cert_hostname"].(string); certHostname != "" {
+				return warnings, fmt.Errorf("backend '%s' sets ssl_cert_hostname but has use_ssl disabled", name)
+			}
+			if sniHostname, _ := bf["ssl_sni_hostname"].(string); sniHostname != "" {
+				return warnings, fmt.Errorf("backend '%s' sets ssl_sni_hostname but has use_ssl disabled", name)
+			}
+		}
+
+		if ignoreMismatch {
+			continue
+		}
+
+		if useSSL && port == 80 {
+			warnings = append(warnings, fmt.Sprintf("backend '%s' has use_ssl enabled but port 80; set ignore_ssl_port_mismatch if this is intentional", name))
+		}
+		if !useSSL && port == 443 {
+			warnings = append(warnings, fmt.Sprintf("backend '%s' has port 443 but use_ssl disabled; set ignore_ssl_port_mismatch if this is intentional", name))
+		}
+	}
+
+	return warnings, nil
+}
+
 func flattenBackends(backendList []*gofastly.Backend) []map[string]interface{} {
 	var bl []map[string]interface{}
 	for _, b := range backendList {
@@ -311,6 +484,9 @@ func flattenBackends(backendList []*gofastly.Backend) []map[string]interface{} {
 			"weight":                int(b.Weight),
 			"request_condition":     b.RequestCondition,
 			"healthcheck":           b.HealthCheck,
+
+			// Config-only; overwritten from the prior state during Read.
+			"ignore_ssl_port_mismatch": false,
 		}
 
 		bl = append(bl, nb)
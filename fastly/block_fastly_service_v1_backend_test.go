@@ -0,0 +1,132 @@
+package fastly
+
+import (
+	"testing"
+)
+
+func TestResourceFastlyCheckBackendHealthCheckReferences(t *testing.T) {
+	healthChecks := []interface{}{
+		map[string]interface{}{
+			"name": "example healthcheck",
+		},
+	}
+
+	cases := []struct {
+		backends  []interface{}
+		expectErr bool
+	}{
+		{
+			backends: []interface{}{
+				map[string]interface{}{
+					"name":        "defined reference",
+					"healthcheck": "example healthcheck",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			backends: []interface{}{
+				map[string]interface{}{
+					"name":        "no reference",
+					"healthcheck": "",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			backends: []interface{}{
+				map[string]interface{}{
+					"name":        "dangling reference",
+					"healthcheck": "missing healthcheck",
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		err := checkBackendHealthCheckReferences(c.backends, healthChecks)
+		if c.expectErr && err == nil {
+			t.Fatalf("Expected error for backends %#v, got none", c.backends)
+		}
+		if !c.expectErr && err != nil {
+			t.Fatalf("Unexpected error for backends %#v: %s", c.backends, err)
+		}
+	}
+}
+
+func TestResourceFastlyCheckBackendSSLConsistency(t *testing.T) {
+	cases := []struct {
+		backend          map[string]interface{}
+		expectedWarnings int
+		expectErr        bool
+	}{
+		{
+			backend: map[string]interface{}{
+				"name":    "consistent ssl",
+				"use_ssl": true,
+				"port":    443,
+			},
+			expectedWarnings: 0,
+		},
+		{
+			backend: map[string]interface{}{
+				"name":    "ssl on port 80",
+				"use_ssl": true,
+				"port":    80,
+			},
+			expectedWarnings: 1,
+		},
+		{
+			backend: map[string]interface{}{
+				"name":    "plaintext on port 443",
+				"use_ssl": false,
+				"port":    443,
+			},
+			expectedWarnings: 1,
+		},
+		{
+			backend: map[string]interface{}{
+				"name":                     "suppressed mismatch",
+				"use_ssl":                  false,
+				"port":                     443,
+				"ignore_ssl_port_mismatch": true,
+			},
+			expectedWarnings: 0,
+		},
+		{
+			backend: map[string]interface{}{
+				"name":              "cert hostname without ssl",
+				"use_ssl":           false,
+				"port":              80,
+				"ssl_cert_hostname": "example.com",
+			},
+			expectErr: true,
+		},
+		{
+			backend: map[string]interface{}{
+				"name":             "sni hostname without ssl",
+				"use_ssl":          false,
+				"port":             80,
+				"ssl_sni_hostname": "example.com",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		warnings, err := checkBackendSSLConsistency([]interface{}{c.backend})
+		if c.expectErr {
+			if err == nil {
+				t.Fatalf("Expected error for backend %#v, got none", c.backend)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error for backend %#v: %s", c.backend, err)
+		}
+		if len(warnings) != c.expectedWarnings {
+			t.Fatalf("Expected %d warnings for backend %#v, got %#v", c.expectedWarnings, c.backend, warnings)
+		}
+	}
+}
@@ -47,10 +47,10 @@ func (h *BigQueryLoggingServiceAttributeHandler) Process(d *schema.ResourceData,
 		err := conn.DeleteBigQuery(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteBigQuery", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteBigQuery", err)
 		}
 	}
 
@@ -93,7 +93,7 @@ func (h *BigQueryLoggingServiceAttributeHandler) Process(d *schema.ResourceData,
 		log.Printf("[DEBUG] Create bigquerylogging opts: %#v", opts)
 		_, err := conn.CreateBigQuery(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateBigQuery", err)
 		}
 	}
 	return nil
@@ -163,10 +163,11 @@ func (h *BigQueryLoggingServiceAttributeHandler) Register(s *schema.Resource) er
 					StateFunc: trimSpaceStateFunc,
 				},
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "The logging format desired.",
-					Default:     "%h %l %u %t \"%r\" %>s %b",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The logging format desired.",
+					Default:      "%h %l %u %t \"%r\" %>s %b",
+					ValidateFunc: validateLogFormat(),
 				},
 				"response_condition": {
 					Type:        schema.TypeString,
@@ -48,10 +48,10 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Process(d *schema.ResourceDa
 		err := conn.DeleteBlobStorage(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteBlobStorage", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteBlobStorage", err)
 		}
 	}
 
@@ -80,7 +80,7 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Process(d *schema.ResourceDa
 		log.Printf("[DEBUG] Blob Storage logging create opts: %#v", opts)
 		_, err := conn.CreateBlobStorage(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateBlobStorage", err)
 		}
 	}
 	return nil
@@ -164,10 +164,11 @@ func (h *BlobStorageLoggingServiceAttributeHandler) Register(s *schema.Resource)
 					Description: "The PGP public key that Fastly will use to encrypt your log files before writing them to disk",
 				},
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t \"%r\" %>s %b",
-					Description: "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t \"%r\" %>s %b",
+					Description:  "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+					ValidateFunc: validateLogFormat(),
 				},
 				"format_version": {
 					Type:         schema.TypeInt,
@@ -49,16 +49,18 @@ func (h *CacheSettingServiceAttributeHandler) Process(d *schema.ResourceData, la
 		err := conn.DeleteCacheSetting(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteCacheSetting", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteCacheSetting", err)
 		}
 	}
 
 	// POST new Cache Settings
 	for _, dRaw := range add {
-		opts, err := buildCacheSetting(dRaw.(map[string]interface{}))
+		df := dRaw.(map[string]interface{})
+		applyCacheSettingDefaultTTL(df, d.Get("default_ttl").(int))
+		opts, err := buildCacheSetting(df)
 		if err != nil {
 			log.Printf("[DEBUG] Error building Cache Setting: %s", err)
 			return err
@@ -69,7 +71,7 @@ func (h *CacheSettingServiceAttributeHandler) Process(d *schema.ResourceData, la
 		log.Printf("[DEBUG] Fastly Cache Settings Addition opts: %#v", opts)
 		_, err = conn.CreateCacheSetting(opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateCacheSetting", err)
 		}
 	}
 	return nil
@@ -87,6 +89,31 @@ func (h *CacheSettingServiceAttributeHandler) Read(d *schema.ResourceData, s *go
 
 	csl := flattenCacheSettings(cslList)
 
+	// inherit_default_ttl only exists in the configuration, so carry it over
+	// from the prior state; for inherited elements also restore the configured
+	// (empty) ttl so the copied default_ttl doesn't churn the set hash.
+	if prior, ok := d.GetOk(h.GetKey()); ok {
+		type priorSetting struct {
+			inherit bool
+			ttl     int
+		}
+		priors := make(map[string]priorSetting)
+		for _, csRaw := range prior.(*schema.Set).List() {
+			cf := csRaw.(map[string]interface{})
+			inherit, _ := cf["inherit_default_ttl"].(bool)
+			priors[cf["name"].(string)] = priorSetting{inherit, cf["ttl"].(int)}
+		}
+
+		for _, nc := range csl {
+			if p, ok := priors[nc["name"].(string)]; ok && p.inherit {
+				nc["inherit_default_ttl"] = true
+				if nc["ttl"] == uint(d.Get("default_ttl").(int)) {
+					nc["ttl"] = p.ttl
+				}
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), csl); err != nil {
 		log.Printf("[WARN] Error setting Cache Settings for (%s): %s", d.Id(), err)
 	}
@@ -127,12 +154,56 @@ func (h *CacheSettingServiceAttributeHandler) Register(s *schema.Resource) error
 					Optional:    true,
 					Description: "The 'Time To Live' for the object",
 				},
+				"inherit_default_ttl": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Use the service default_ttl as this Cache Setting's TTL when no ttl is given. Without this, a cache_setting with no ttl falls back to the origin's caching headers, not default_ttl",
+				},
 			},
 		},
 	}
 	return nil
 }
 
+// applyCacheSettingDefaultTTL copies the service default_ttl into a cache
+// setting element that opted in via inherit_default_ttl and gave no ttl of
+// its own. The API applies default_ttl only to requests not matched by a
+// cache setting, so without the copy such objects would use the origin's
+// caching headers instead.
+func applyCacheSettingDefaultTTL(df map[string]interface{}, defaultTTL int) {
+	inherit, _ := df["inherit_default_ttl"].(bool)
+	if inherit && df["ttl"].(int) == 0 {
+		df["ttl"] = defaultTTL
+	}
+}
+
+// cacheSettingTTLWarnings reports cache_setting elements with action "cache"
+// but no ttl, which commonly surprises users expecting default_ttl to apply.
+func cacheSettingTTLWarnings(cacheSettings []interface{}) []string {
+	var warnings []string
+	for _, csRaw := range cacheSettings {
+		cs := csRaw.(map[string]interface{})
+		inherit, _ := cs["inherit_default_ttl"].(bool)
+		if cs["action"].(string) == "cache" && cs["ttl"].(int) == 0 && !inherit {
+			warnings = append(warnings, fmt.Sprintf("cache_setting %q has action 'cache' but no ttl; matched objects use the origin's caching headers, not default_ttl. Set ttl or inherit_default_ttl to silence this", cs["name"].(string)))
+		}
+	}
+	return warnings
+}
+
+// warnCacheSettingTTL surfaces cacheSettingTTLWarnings at plan time.
+func warnCacheSettingTTL(d *schema.ResourceDiff, _ interface{}) error {
+	cacheSettings, ok := d.Get("cache_setting").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	for _, w := range cacheSettingTTLWarnings(cacheSettings.List()) {
+		log.Printf("[WARN] %s", w)
+	}
+	return nil
+}
+
 func buildCacheSetting(cacheMap interface{}) (*gofastly.CreateCacheSettingInput, error) {
 	df := cacheMap.(map[string]interface{})
 	opts := gofastly.CreateCacheSettingInput{
@@ -163,11 +234,12 @@ func flattenCacheSettings(csList []*gofastly.CacheSetting) []map[string]interfac
 	for _, cl := range csList {
 		// Convert Cache Settings to a map for saving to state.
 		clMap := map[string]interface{}{
-			"name":            cl.Name,
-			"action":          cl.Action,
-			"cache_condition": cl.CacheCondition,
-			"stale_ttl":       cl.StaleTTL,
-			"ttl":             cl.TTL,
+			"name":                cl.Name,
+			"action":              cl.Action,
+			"cache_condition":     cl.CacheCondition,
+			"stale_ttl":           cl.StaleTTL,
+			"ttl":                 cl.TTL,
+			"inherit_default_ttl": false,
 		}
 
 		// prune any empty values that come from the default string value in structs
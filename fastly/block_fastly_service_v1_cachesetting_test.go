@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
@@ -29,11 +30,12 @@ func TestResourceFastlyFlattenCacheSettings(t *testing.T) {
 			},
 			local: []map[string]interface{}{
 				{
-					"name":            "alt_backend",
-					"action":          gofastly.CacheSettingActionPass,
-					"cache_condition": "serve_alt_backend",
-					"stale_ttl":       uint(3600),
-					"ttl":             uint(300),
+					"name":                "alt_backend",
+					"action":              gofastly.CacheSettingActionPass,
+					"cache_condition":     "serve_alt_backend",
+					"stale_ttl":           uint(3600),
+					"ttl":                 uint(300),
+					"inherit_default_ttl": false,
 				},
 			},
 		},
@@ -244,3 +246,57 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, domain)
 }
+
+func TestResourceFastlyApplyCacheSettingDefaultTTL(t *testing.T) {
+	cases := []struct {
+		name       string
+		setting    map[string]interface{}
+		defaultTTL int
+		wantTTL    int
+	}{
+		{
+			name:       "inherits when opted in and no ttl",
+			setting:    map[string]interface{}{"inherit_default_ttl": true, "ttl": 0},
+			defaultTTL: 3600,
+			wantTTL:    3600,
+		},
+		{
+			name:       "explicit ttl wins",
+			setting:    map[string]interface{}{"inherit_default_ttl": true, "ttl": 300},
+			defaultTTL: 3600,
+			wantTTL:    300,
+		},
+		{
+			name:       "no inheritance without the flag",
+			setting:    map[string]interface{}{"inherit_default_ttl": false, "ttl": 0},
+			defaultTTL: 3600,
+			wantTTL:    0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			applyCacheSettingDefaultTTL(c.setting, c.defaultTTL)
+			if got := c.setting["ttl"].(int); got != c.wantTTL {
+				t.Errorf("expected ttl (%d), got (%d)", c.wantTTL, got)
+			}
+		})
+	}
+}
+
+func TestResourceFastlyCacheSettingTTLWarnings(t *testing.T) {
+	cacheSettings := []interface{}{
+		map[string]interface{}{"name": "no-ttl", "action": "cache", "ttl": 0, "inherit_default_ttl": false},
+		map[string]interface{}{"name": "with-ttl", "action": "cache", "ttl": 300, "inherit_default_ttl": false},
+		map[string]interface{}{"name": "inherited", "action": "cache", "ttl": 0, "inherit_default_ttl": true},
+		map[string]interface{}{"name": "passed", "action": "pass", "ttl": 0, "inherit_default_ttl": false},
+	}
+
+	warnings := cacheSettingTTLWarnings(cacheSettings)
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %d: %v", len(warnings), warnings)
+	}
+	if !strings.Contains(warnings[0], "no-ttl") {
+		t.Errorf("expected warning to name the cache_setting, got: %s", warnings[0])
+	}
+}
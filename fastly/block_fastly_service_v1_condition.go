@@ -54,10 +54,10 @@ func (h *ConditionServiceAttributeHandler) Process(d *schema.ResourceData, lates
 		err := conn.DeleteCondition(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteCondition", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteCondition", err)
 		}
 	}
 
@@ -78,7 +78,7 @@ func (h *ConditionServiceAttributeHandler) Process(d *schema.ResourceData, lates
 		log.Printf("[DEBUG] Create Conditions Opts: %#v", opts)
 		_, err := conn.CreateCondition(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateCondition", err)
 		}
 	}
 	return nil
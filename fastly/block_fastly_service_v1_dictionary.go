@@ -36,9 +36,24 @@ func (h *DictionaryServiceAttributeHandler) Process(d *schema.ResourceData, late
 	remove := oldDictSet.Difference(newDictSet).List()
 	add := newDictSet.Difference(oldDictSet).List()
 
+	// A refresh fills in the computed dictionary_id and force_destroy only
+	// exists in configuration; either changing alone would delete and
+	// recreate the dictionary — discarding its items — without any real
+	// change, so skip elements that only differ there.
+	remove, add = pruneSemanticallyUnchangedElements(remove, add, "dictionary_id", "force_destroy")
+
 	// Delete removed dictionary configurations
 	for _, dRaw := range remove {
 		df := dRaw.(map[string]interface{})
+
+		// The API refuses to delete a dictionary that still has items, so
+		// clear them out first when the block opts in via force_destroy.
+		if force, _ := df["force_destroy"].(bool); force {
+			if err := deleteDictionaryItems(conn, d.Id(), df["dictionary_id"].(string)); err != nil {
+				return err
+			}
+		}
+
 		opts := gofastly.DeleteDictionaryInput{
 			Service: d.Id(),
 			Version: latestVersion,
@@ -49,10 +64,10 @@ func (h *DictionaryServiceAttributeHandler) Process(d *schema.ResourceData, late
 		err := conn.DeleteDictionary(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteDictionary", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteDictionary", err)
 		}
 	}
 
@@ -69,7 +84,7 @@ func (h *DictionaryServiceAttributeHandler) Process(d *schema.ResourceData, late
 		log.Printf("[DEBUG] Fastly Dictionary Addition opts: %#v", opts)
 		_, err = conn.CreateDictionary(opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateDictionary", err)
 		}
 	}
 	return nil
@@ -87,6 +102,23 @@ func (h *DictionaryServiceAttributeHandler) Read(d *schema.ResourceData, s *gofa
 
 	dict := flattenDictionaries(dictList)
 
+	// force_destroy only exists in the configuration, so carry it over from
+	// the prior state; otherwise a refresh would reset the flag and churn the
+	// set hash.
+	if prior, ok := d.GetOk(h.GetKey()); ok {
+		flags := make(map[string]bool)
+		for _, dRaw := range prior.(*schema.Set).List() {
+			df := dRaw.(map[string]interface{})
+			flags[df["name"].(string)], _ = df["force_destroy"].(bool)
+		}
+
+		for _, nd := range dict {
+			if v, ok := flags[nd["name"].(string)]; ok {
+				nd["force_destroy"] = v
+			}
+		}
+	}
+
 	if err := d.Set(h.GetKey(), dict); err != nil {
 		log.Printf("[WARN] Error setting Dictionary for (%s): %s", d.Id(), err)
 	}
@@ -117,12 +149,59 @@ func (h *DictionaryServiceAttributeHandler) Register(s *schema.Resource) error {
 					Default:     false,
 					Description: "Determines if items in the dictionary are readable or not",
 				},
+				"force_destroy": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Delete any remaining items in the dictionary before removing it. Without this, removing a dictionary that still has items fails",
+				},
 			},
 		},
 	}
 	return nil
 }
 
+// deleteDictionaryItems removes every item from a dictionary so that the
+// dictionary itself can be deleted. Items are removed in batches of at most
+// BatchModifyMaximumOperations, the largest batch the API accepts.
+func deleteDictionaryItems(conn *gofastly.Client, serviceID, dictionaryID string) error {
+	items, err := conn.ListDictionaryItems(&gofastly.ListDictionaryItemsInput{
+		Service:    serviceID,
+		Dictionary: dictionaryID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for len(items) > 0 {
+		batchSize := gofastly.BatchModifyMaximumOperations
+		if len(items) < batchSize {
+			batchSize = len(items)
+		}
+
+		deletions := make([]*gofastly.BatchDictionaryItem, 0, batchSize)
+		for _, item := range items[:batchSize] {
+			deletions = append(deletions, &gofastly.BatchDictionaryItem{
+				Operation: gofastly.DeleteBatchOperation,
+				ItemKey:   item.ItemKey,
+			})
+		}
+
+		log.Printf("[DEBUG] Fastly Dictionary item batch removal for dictionary (%s): %d items", dictionaryID, len(deletions))
+		if err := conn.BatchModifyDictionaryItems(&gofastly.BatchModifyDictionaryItemsInput{
+			Service:    serviceID,
+			Dictionary: dictionaryID,
+			Items:      deletions,
+		}); err != nil {
+			return err
+		}
+
+		items = items[batchSize:]
+	}
+
+	return nil
+}
+
 func flattenDictionaries(dictList []*gofastly.Dictionary) []map[string]interface{} {
 	var dl []map[string]interface{}
 	for _, currentDict := range dictList {
@@ -131,6 +210,8 @@ func flattenDictionaries(dictList []*gofastly.Dictionary) []map[string]interface
 			"dictionary_id": currentDict.ID,
 			"name":          currentDict.Name,
 			"write_only":    currentDict.WriteOnly,
+			// Config-only flag; Read carries the real value over from state.
+			"force_destroy": false,
 		}
 
 		// prune any empty values that come from the default string value in structs
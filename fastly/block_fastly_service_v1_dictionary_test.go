@@ -29,6 +29,7 @@ func TestResourceFastlyFlattenDictionary(t *testing.T) {
 					"dictionary_id": "1234567890",
 					"name":          "dictionary-example",
 					"write_only":    false,
+					"force_destroy": false,
 				},
 			},
 		},
@@ -142,6 +143,46 @@ func TestAccFastlyServiceV1_dictionary_update_write_only(t *testing.T) {
 	})
 }
 
+func TestAccFastlyServiceV1_dictionary_forceDestroy(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	dictName := fmt.Sprintf("dict %s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1Config_dictionaryForceDestroyWithItems(name, dictName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_dictionary(&service, name, dictName, false),
+				),
+			},
+
+			// Toggling the flag on an existing dictionary must be a
+			// state-only change, not a delete/recreate of the dictionary.
+			{
+				Config: testAccServiceV1Config_dictionaryForceDestroyWithItems(name, dictName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1Attributes_dictionary(&service, name, dictName, false),
+				),
+			},
+
+			{
+				Config: testAccServiceV1Config_dictionaryForceDestroyRemoved(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "dictionary.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckFastlyServiceV1Attributes_dictionary(service *gofastly.ServiceDetail, name, dictName string, writeOnly bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -224,3 +265,65 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, domainName, backendName, dictName, writeOnly)
 }
+
+func testAccServiceV1Config_dictionaryForceDestroyWithItems(name, dictName string, force bool) string {
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+variable "mydict_name" {
+	type = string
+	default = "%s"
+}
+
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "tf-test-backend"
+  }
+
+  dictionary {
+    name          = var.mydict_name
+    force_destroy = %t
+  }
+
+  force_destroy = true
+}
+
+resource "fastly_service_dictionary_items_v1" "items" {
+  service_id    = fastly_service_v1.foo.id
+  dictionary_id = {for d in fastly_service_v1.foo.dictionary : d.name => d.dictionary_id}[var.mydict_name]
+
+  items = {
+    key1 = "value1"
+    key2 = "value2"
+  }
+}`, dictName, name, domainName, force)
+}
+
+func testAccServiceV1Config_dictionaryForceDestroyRemoved(name string) string {
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "tf-test-backend"
+  }
+
+  force_destroy = true
+}`, name, domainName)
+}
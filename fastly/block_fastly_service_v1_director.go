@@ -35,6 +35,14 @@ func (h *DirectorServiceAttributeHandler) Process(d *schema.ResourceData, latest
 	removeDirector := ods.Difference(nds).List()
 	addDirector := nds.Difference(ods).List()
 
+	// Flag director/backend combinations that are unlikely to behave as
+	// intended. These remain warnings, since the API accepts them.
+	if backends, ok := d.GetOk("backend"); ok {
+		for _, w := range directorBackendWarnings(nds.List(), backends.(*schema.Set).List()) {
+			log.Printf("[WARN] %s", w)
+		}
+	}
+
 	// DELETE old director configurations
 	for _, dRaw := range removeDirector {
 		df := dRaw.(map[string]interface{})
@@ -48,10 +56,10 @@ func (h *DirectorServiceAttributeHandler) Process(d *schema.ResourceData, latest
 		err := conn.DeleteDirector(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteDirector", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteDirector", err)
 		}
 	}
 
@@ -83,7 +91,7 @@ func (h *DirectorServiceAttributeHandler) Process(d *schema.ResourceData, latest
 		log.Printf("[DEBUG] Director Create opts: %#v", opts)
 		_, err := conn.CreateDirector(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateDirector", err)
 		}
 
 		if v, ok := df["backends"]; ok {
@@ -99,7 +107,7 @@ func (h *DirectorServiceAttributeHandler) Process(d *schema.ResourceData, latest
 					log.Printf("[DEBUG] Director Backend Create opts: %#v", opts)
 					_, err := conn.CreateDirectorBackend(&opts)
 					if err != nil {
-						return err
+						return wrapBlockError(h.GetKey(), opts.Director, "CreateDirectorBackend", err)
 					}
 				}
 			}
@@ -215,6 +223,46 @@ func (h *DirectorServiceAttributeHandler) Register(s *schema.Resource) error {
 	return nil
 }
 
+// directorBackendWarnings inspects each director's backend references against
+// the service's backend blocks and returns a warning for every combination
+// that is valid to the API but unlikely to be intended: a reference to a
+// backend not defined on the service, or a client director (type 4) whose
+// member backend has auto_loadbalance disabled and so will never be selected
+// by client-key balancing.
+func directorBackendWarnings(directors, backends []interface{}) []string {
+	autoLoadbalance := make(map[string]bool, len(backends))
+	for _, bRaw := range backends {
+		bf := bRaw.(map[string]interface{})
+		autoLoadbalance[bf["name"].(string)] = bf["auto_loadbalance"].(bool)
+	}
+
+	var warnings []string
+	for _, dRaw := range directors {
+		df := dRaw.(map[string]interface{})
+
+		members, ok := df["backends"].(*schema.Set)
+		if !ok {
+			continue
+		}
+
+		for _, mRaw := range members.List() {
+			member := mRaw.(string)
+
+			lb, defined := autoLoadbalance[member]
+			if !defined {
+				warnings = append(warnings, fmt.Sprintf("director '%s' references backend '%s' which is not defined in the 'backend' block of this service", df["name"], member))
+				continue
+			}
+
+			if df["type"].(int) == 4 && !lb {
+				warnings = append(warnings, fmt.Sprintf("director '%s' is a client director but backend '%s' has auto_loadbalance disabled", df["name"], member))
+			}
+		}
+	}
+
+	return warnings
+}
+
 func flattenDirectors(directorList []*gofastly.Director, directorBackendList []*gofastly.DirectorBackend) []map[string]interface{} {
 	var dl []map[string]interface{}
 	for _, d := range directorList {
@@ -306,3 +306,155 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, domain)
 }
+
+func TestResourceFastlyDirectorBackendWarnings(t *testing.T) {
+	backends := []interface{}{
+		map[string]interface{}{
+			"name":             "balanced",
+			"auto_loadbalance": true,
+		},
+		map[string]interface{}{
+			"name":             "unbalanced",
+			"auto_loadbalance": false,
+		},
+	}
+
+	cases := []struct {
+		directors []interface{}
+		expected  []string
+	}{
+		{
+			directors: []interface{}{
+				map[string]interface{}{
+					"name":     "random director",
+					"type":     1,
+					"backends": schema.NewSet(schema.HashString, []interface{}{"balanced", "unbalanced"}),
+				},
+			},
+			expected: nil,
+		},
+		{
+			directors: []interface{}{
+				map[string]interface{}{
+					"name":     "client director",
+					"type":     4,
+					"backends": schema.NewSet(schema.HashString, []interface{}{"unbalanced"}),
+				},
+			},
+			expected: []string{
+				"director 'client director' is a client director but backend 'unbalanced' has auto_loadbalance disabled",
+			},
+		},
+		{
+			directors: []interface{}{
+				map[string]interface{}{
+					"name":     "dangling director",
+					"type":     1,
+					"backends": schema.NewSet(schema.HashString, []interface{}{"missing"}),
+				},
+			},
+			expected: []string{
+				"director 'dangling director' references backend 'missing' which is not defined in the 'backend' block of this service",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := directorBackendWarnings(c.directors, backends)
+		if !reflect.DeepEqual(out, c.expected) {
+			t.Fatalf("Error matching:\nexpected: %#v\n     got: %#v", c.expected, out)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_Director_comment(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName1 := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1DirectorCommentConfig(name, domainName1, "initial comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "director.#", "1"),
+					testAccCheckFastlyServiceV1DirectorComment(&service, "mydirector", "initial comment"),
+				),
+			},
+
+			{
+				Config: testAccServiceV1DirectorCommentConfig(name, domainName1, "updated comment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1DirectorComment(&service, "mydirector", "updated comment"),
+				),
+			},
+
+			// Clearing the comment should round-trip as an empty string, which
+			// exercises the empty-string pruning in flattenDirectors.
+			{
+				Config: testAccServiceV1DirectorCommentConfig(name, domainName1, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1DirectorComment(&service, "mydirector", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1DirectorComment(service *gofastly.ServiceDetail, director, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		directorList, err := conn.ListDirectors(&gofastly.ListDirectorsInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Directors for (%s), version (%v): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		for _, d := range directorList {
+			if d.Name == director {
+				if d.Comment != want {
+					return fmt.Errorf("Director comment mismatch, expected (%q), got (%q)", want, d.Comment)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Director (%s) not found", director)
+	}
+}
+
+func testAccServiceV1DirectorCommentConfig(name, domain, comment string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "developer.fastly.com"
+    name    = "origin old"
+  }
+
+  director {
+    name     = "mydirector"
+    type     = 3
+    comment  = "%s"
+    backends = [ "origin old" ]
+  }
+
+  force_destroy = true
+}`, name, domain, comment)
+}
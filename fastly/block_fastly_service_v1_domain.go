@@ -48,10 +48,10 @@ func (h *DomainServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		err := conn.DeleteDomain(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteDomain", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteDomain", err)
 		}
 	}
 
@@ -71,7 +71,7 @@ func (h *DomainServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Domain Addition opts: %#v", opts)
 		_, err := conn.CreateDomain(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateDomain", err)
 		}
 	}
 	return nil
@@ -38,6 +38,11 @@ func (h *DynamicSnippetServiceAttributeHandler) Process(d *schema.ResourceData,
 	remove := oldDynamicSnippetSet.Difference(newDynamicSnippetSet).List()
 	add := newDynamicSnippetSet.Difference(oldDynamicSnippetSet).List()
 
+	// A refresh fills in the computed snippet_id, which changes the set hash
+	// without any real change. Recreating the snippet would discard its
+	// dynamic content, so skip elements that only differ there.
+	remove, add = pruneSemanticallyUnchangedElements(remove, add, "snippet_id")
+
 	// Delete removed VCL Snippet configurations
 	for _, dRaw := range remove {
 		df := dRaw.(map[string]interface{})
@@ -51,14 +56,15 @@ func (h *DynamicSnippetServiceAttributeHandler) Process(d *schema.ResourceData,
 		err := conn.DeleteSnippet(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteSnippet", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteSnippet", err)
 		}
 	}
 
 	// POST new VCL Snippet configurations
+	sortSnippets(add)
 	for _, dRaw := range add {
 		opts, err := buildDynamicSnippet(dRaw.(map[string]interface{}))
 		if err != nil {
@@ -71,7 +77,7 @@ func (h *DynamicSnippetServiceAttributeHandler) Process(d *schema.ResourceData,
 		log.Printf("[DEBUG] Fastly VCL Dynamic Snippet Addition opts: %#v", opts)
 		_, err = conn.CreateSnippet(opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateSnippet", err)
 		}
 	}
 
@@ -1,13 +1,53 @@
 package fastly
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
+// gcsServiceAccountCredentials is the subset of a Google service-account JSON
+// key that GCS logging needs.
+type gcsServiceAccountCredentials struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+}
+
+// parseGCSServiceAccountKey interprets secret_key either as a raw private key,
+// returned unchanged, or, when it is a JSON document, as a full
+// service-account key from which the email and private key are extracted.
+func parseGCSServiceAccountKey(secretKey string) (email string, privateKey string, err error) {
+	trimmed := strings.TrimSpace(secretKey)
+	if !strings.HasPrefix(trimmed, "{") {
+		return "", secretKey, nil
+	}
+
+	var creds gcsServiceAccountCredentials
+	if err := json.Unmarshal([]byte(trimmed), &creds); err != nil {
+		return "", "", fmt.Errorf("secret_key looks like a JSON service-account key but does not parse: %s", err)
+	}
+	if creds.PrivateKey == "" {
+		return "", "", fmt.Errorf("secret_key JSON service-account key is missing private_key")
+	}
+
+	return creds.ClientEmail, creds.PrivateKey, nil
+}
+
+// validateGCSSecretKey rejects malformed JSON service-account keys at plan
+// time, before the API sees them.
+func validateGCSSecretKey() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		if _, _, err := parseGCSServiceAccountKey(val.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("%q: %s", key, err))
+		}
+		return
+	}
+}
+
 type GCSLoggingServiceAttributeHandler struct {
 	*DefaultServiceAttributeHandler
 }
@@ -47,23 +87,34 @@ func (h *GCSLoggingServiceAttributeHandler) Process(d *schema.ResourceData, late
 		err := conn.DeleteGCS(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteGCS", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteGCS", err)
 		}
 	}
 
 	// POST new/updated gcslogging
 	for _, pRaw := range addGcslogging {
 		sf := pRaw.(map[string]interface{})
+
+		// secret_key may be a full JSON service-account key, in which case the
+		// email and private key are extracted from it.
+		email, secretKey, err := parseGCSServiceAccountKey(sf["secret_key"].(string))
+		if err != nil {
+			return err
+		}
+		if v := sf["email"].(string); v != "" {
+			email = v
+		}
+
 		opts := gofastly.CreateGCSInput{
 			Service:           d.Id(),
 			Version:           latestVersion,
 			Name:              sf["name"].(string),
-			User:              sf["email"].(string),
+			User:              email,
 			Bucket:            sf["bucket_name"].(string),
-			SecretKey:         sf["secret_key"].(string),
+			SecretKey:         secretKey,
 			Format:            sf["format"].(string),
 			Path:              sf["path"].(string),
 			Period:            uint(sf["period"].(int)),
@@ -75,9 +126,8 @@ func (h *GCSLoggingServiceAttributeHandler) Process(d *schema.ResourceData, late
 		}
 
 		log.Printf("[DEBUG] Create GCS Opts: %#v", opts)
-		_, err := conn.CreateGCS(&opts)
-		if err != nil {
-			return err
+		if _, err := conn.CreateGCS(&opts); err != nil {
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateGCS", err)
 		}
 	}
 	return nil
@@ -95,6 +145,15 @@ func (h *GCSLoggingServiceAttributeHandler) Read(d *schema.ResourceData, s *gofa
 	}
 
 	gcsl := flattenGCS(GCSList)
+
+	// Process decomposes a JSON service-account secret_key into the email and
+	// private key actually sent to the API. Reading those decomposed values
+	// back into state would never hash equal to the configured element, so
+	// keep the configured form while it still resolves to what the API holds.
+	if prior, ok := d.GetOk(h.GetKey()); ok {
+		restoreConfiguredGCSCredentials(prior.(*schema.Set).List(), gcsl)
+	}
+
 	if err := d.Set(h.GetKey(), gcsl); err != nil {
 		log.Printf("[WARN] Error setting gcs for (%s): %s", d.Id(), err)
 	}
@@ -126,11 +185,12 @@ func (h *GCSLoggingServiceAttributeHandler) Register(s *schema.Resource) error {
 					Description: "The name of the bucket in which to store the logs.",
 				},
 				"secret_key": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					DefaultFunc: schema.EnvDefaultFunc("FASTLY_GCS_SECRET_KEY", ""),
-					Description: "The secret key associated with the target gcs bucket on your account.",
-					Sensitive:   true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("FASTLY_GCS_SECRET_KEY", ""),
+					Description:  "The secret key associated with the target gcs bucket on your account. May also be a full JSON service-account key, in which case the email is taken from it and the `email` field may be omitted.",
+					Sensitive:    true,
+					ValidateFunc: validateGCSSecretKey(),
 				},
 				// Optional fields
 				"path": {
@@ -151,10 +211,11 @@ func (h *GCSLoggingServiceAttributeHandler) Register(s *schema.Resource) error {
 					Description: "How frequently the logs should be transferred, in seconds (Default 3600)",
 				},
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t %r %>s",
-					Description: "Apache-style string or VCL variables to use for log formatting",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t %r %>s",
+					Description:  "Apache-style string or VCL variables to use for log formatting",
+					ValidateFunc: validateLogFormat(),
 				},
 				"timestamp_format": {
 					Type:        schema.TypeString,
@@ -186,6 +247,47 @@ func (h *GCSLoggingServiceAttributeHandler) Register(s *schema.Resource) error {
 	return nil
 }
 
+// restoreConfiguredGCSCredentials rewrites the flattened email and secret_key
+// with the configured values from the prior state, matched by name, when
+// those values still resolve to what the API returned. A genuinely drifted
+// endpoint is left alone so the real values surface as a diff.
+func restoreConfiguredGCSCredentials(prior []interface{}, flattened []map[string]interface{}) {
+	byName := make(map[string]map[string]interface{}, len(prior))
+	for _, pRaw := range prior {
+		pf := pRaw.(map[string]interface{})
+		name, _ := pf["name"].(string)
+		byName[name] = pf
+	}
+
+	for _, ng := range flattened {
+		name, _ := ng["name"].(string)
+		pf, ok := byName[name]
+		if !ok {
+			continue
+		}
+
+		priorSecret, _ := pf["secret_key"].(string)
+		priorEmail, _ := pf["email"].(string)
+
+		email, secretKey, err := parseGCSServiceAccountKey(priorSecret)
+		if err != nil {
+			continue
+		}
+		if priorEmail != "" {
+			email = priorEmail
+		}
+
+		apiEmail, _ := ng["email"].(string)
+		apiSecret, _ := ng["secret_key"].(string)
+		if (apiEmail != "" && apiEmail != email) || (apiSecret != "" && apiSecret != secretKey) {
+			continue
+		}
+
+		ng["email"] = priorEmail
+		ng["secret_key"] = priorSecret
+	}
+}
+
 func flattenGCS(gcsList []*gofastly.GCS) []map[string]interface{} {
 	var GCSList []map[string]interface{}
 	for _, currentGCS := range gcsList {
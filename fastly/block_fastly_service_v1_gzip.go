@@ -49,10 +49,10 @@ func (h *GZIPServiceAttributeHandler) Process(d *schema.ResourceData, latestVers
 		err := conn.DeleteGzip(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteGzip", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteGzip", err)
 		}
 	}
 
@@ -89,7 +89,7 @@ func (h *GZIPServiceAttributeHandler) Process(d *schema.ResourceData, latestVers
 		log.Printf("[DEBUG] Fastly Gzip Addition opts: %#v", opts)
 		_, err := conn.CreateGzip(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateGzip", err)
 		}
 	}
 
@@ -36,6 +36,10 @@ func (h *HeaderServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 	remove := ohs.Difference(nhs).List()
 	add := nhs.Difference(ohs).List()
 
+	// Elements that only differ in a server-computed field would be deleted
+	// and recreated for no semantic change, so skip them.
+	remove, add = pruneSemanticallyUnchangedElements(remove, add, "source", "regex", "substitution")
+
 	// Delete removed headers
 	for _, dRaw := range remove {
 		df := dRaw.(map[string]interface{})
@@ -49,10 +53,10 @@ func (h *HeaderServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		err := conn.DeleteHeader(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteHeader", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteHeader", err)
 		}
 	}
 
@@ -69,7 +73,7 @@ func (h *HeaderServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Header Addition opts: %#v", opts)
 		_, err = conn.CreateHeader(opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateHeader", err)
 		}
 	}
 
@@ -47,10 +47,10 @@ func (h *HealthCheckServiceAttributeHandler) Process(d *schema.ResourceData, lat
 		err := conn.DeleteHealthCheck(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteHealthCheck", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteHealthCheck", err)
 		}
 	}
 
@@ -77,7 +77,7 @@ func (h *HealthCheckServiceAttributeHandler) Process(d *schema.ResourceData, lat
 		log.Printf("[DEBUG] Create Healthcheck Opts: %#v", opts)
 		_, err := conn.CreateHealthCheck(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateHealthCheck", err)
 		}
 	}
 
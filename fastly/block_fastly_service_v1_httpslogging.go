@@ -46,7 +46,7 @@ func (h *HTTPSLoggingServiceAttributeHandler) Process(d *schema.ResourceData, la
 		log.Printf("[DEBUG] Fastly HTTPS logging endpoint removal opts: %#v", opts)
 
 		if err := deleteHTTPS(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteHTTPS", err)
 		}
 	}
 
@@ -58,7 +58,7 @@ func (h *HTTPSLoggingServiceAttributeHandler) Process(d *schema.ResourceData, la
 		log.Printf("[DEBUG] Fastly HTTPS logging addition opts: %#v", opts)
 
 		if err := createHTTPS(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), hf["name"].(string), "CreateHTTPS", err)
 		}
 	}
 
@@ -187,9 +187,10 @@ func (h *HTTPSLoggingServiceAttributeHandler) Register(s *schema.Resource) error
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
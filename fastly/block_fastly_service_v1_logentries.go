@@ -47,10 +47,10 @@ func (h *LogentriesServiceAttributeHandler) Process(d *schema.ResourceData, late
 		err := conn.DeleteLogentries(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteLogentries", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteLogentries", err)
 		}
 	}
 
@@ -74,7 +74,7 @@ func (h *LogentriesServiceAttributeHandler) Process(d *schema.ResourceData, late
 		log.Printf("[DEBUG] Create Logentries Opts: %#v", opts)
 		_, err := conn.CreateLogentries(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateLogentries", err)
 		}
 	}
 
@@ -132,10 +132,11 @@ func (h *LogentriesServiceAttributeHandler) Register(s *schema.Resource) error {
 					Description: "Whether to use TLS for secure logging",
 				},
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t %r %>s",
-					Description: "Apache-style string or VCL variables to use for log formatting",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t %r %>s",
+					Description:  "Apache-style string or VCL variables to use for log formatting",
+					ValidateFunc: validateLogFormat(),
 				},
 				"format_version": {
 					Type:         schema.TypeInt,
@@ -0,0 +1,255 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceFastlyFlattenCloudfiles(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.Cloudfiles
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.Cloudfiles{
+				{
+					Version:         1,
+					Name:            "cloudfiles-endpoint",
+					User:            "user",
+					BucketName:      "bucket",
+					AccessKey:       "secret",
+					Path:            "/logs/",
+					Region:          "ORD",
+					Period:          3600,
+					GzipLevel:       0,
+					MessageType:     "classic",
+					TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+					Format:          "%h %l %u %t \"%r\" %>s %b",
+					FormatVersion:   2,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":             "cloudfiles-endpoint",
+					"user":             "user",
+					"bucket_name":      "bucket",
+					"access_key":       "secret",
+					"path":             "/logs/",
+					"region":           "ORD",
+					"period":           uint(3600),
+					"gzip_level":       uint(0),
+					"message_type":     "classic",
+					"timestamp_format": "%Y-%m-%dT%H:%M:%S.000",
+					"format":           "%h %l %u %t \"%r\" %>s %b",
+					"format_version":   uint(2),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenCloudfiles(c.remote)
+		if diff := cmp.Diff(out, c.local); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_logging_cloudfiles_basic(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	log1 := gofastly.Cloudfiles{
+		Version:         1,
+		Name:            "cloudfiles-endpoint",
+		User:            "user",
+		BucketName:      "bucket",
+		AccessKey:       "s3cr3t",
+		Region:          "ORD",
+		Period:          3600,
+		GzipLevel:       0,
+		MessageType:     "classic",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b",
+		FormatVersion:   2,
+	}
+
+	log1_after_update := gofastly.Cloudfiles{
+		Version:         1,
+		Name:            "cloudfiles-endpoint",
+		User:            "new-user",
+		BucketName:      "new-bucket",
+		AccessKey:       "secret",
+		Path:            "/new/",
+		Region:          "LON",
+		Period:          3601,
+		GzipLevel:       1,
+		MessageType:     "blank",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b %T",
+		FormatVersion:   2,
+	}
+
+	log2 := gofastly.Cloudfiles{
+		Version:         1,
+		Name:            "another-cloudfiles-endpoint",
+		User:            "another-user",
+		BucketName:      "another-bucket",
+		AccessKey:       "another-secret",
+		Region:          "DFW",
+		Period:          3600,
+		GzipLevel:       0,
+		MessageType:     "classic",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b",
+		FormatVersion:   2,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1CloudfilesConfig(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1CloudfilesAttributes(&service, []*gofastly.Cloudfiles{&log1}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_cloudfiles.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccServiceV1CloudfilesConfig_update(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1CloudfilesAttributes(&service, []*gofastly.Cloudfiles{&log1_after_update, &log2}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_cloudfiles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1CloudfilesAttributes(service *gofastly.ServiceDetail, cloudfiles []*gofastly.Cloudfiles) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		cloudfilesList, err := conn.ListCloudfiles(&gofastly.ListCloudfilesInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Cloud Files Logging for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(cloudfilesList) != len(cloudfiles) {
+			return fmt.Errorf("Cloud Files List count mismatch, expected (%d), got (%d)", len(cloudfiles), len(cloudfilesList))
+		}
+
+		log.Printf("[DEBUG] cloudfilesList = %#v\n", cloudfilesList)
+
+		for _, e := range cloudfiles {
+			for _, el := range cloudfilesList {
+				if e.Name == el.Name {
+					// we don't know these things ahead of time, so populate them now
+					e.ServiceID = service.ID
+					e.Version = service.ActiveVersion.Number
+					// We don't track these, so clear them out because we also wont know
+					// these ahead of time
+					el.CreatedAt = nil
+					el.UpdatedAt = nil
+					if diff := cmp.Diff(e, el); diff != "" {
+						return fmt.Errorf("Bad match Cloud Files logging match: %s", diff)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1CloudfilesConfig(name string, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-cloudfiles-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_cloudfiles {
+    name        = "cloudfiles-endpoint"
+    user        = "user"
+    bucket_name = "bucket"
+    access_key  = "s3cr3t"
+    region      = "ORD"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
+
+func testAccServiceV1CloudfilesConfig_update(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-cloudfiles-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_cloudfiles {
+    name         = "cloudfiles-endpoint"
+    user         = "new-user"
+    bucket_name  = "new-bucket"
+    access_key   = "secret"
+    path         = "/new/"
+    region       = "LON"
+    period       = 3601
+    gzip_level   = 1
+    message_type = "blank"
+    format       = "%%h %%l %%u %%t \"%%r\" %%>s %%b %%T"
+  }
+
+  logging_cloudfiles {
+    name        = "another-cloudfiles-endpoint"
+    user        = "another-user"
+    bucket_name = "another-bucket"
+    access_key  = "another-secret"
+    region      = "DFW"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
@@ -45,7 +45,7 @@ func (h *DatadogServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 		log.Printf("[DEBUG] Fastly Datadog logging endpoint removal opts: %#v", opts)
 
 		if err := deleteDatadog(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteDatadog", err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (h *DatadogServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 		log.Printf("[DEBUG] Fastly Datadog logging addition opts: %#v", opts)
 
 		if err := createDatadog(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), df["name"].(string), "CreateDatadog", err)
 		}
 	}
 
@@ -136,9 +136,10 @@ func (h *DatadogServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
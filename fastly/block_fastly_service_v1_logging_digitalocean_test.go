@@ -0,0 +1,253 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceFastlyFlattenDigitalOcean(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.DigitalOcean
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.DigitalOcean{
+				{
+					Version:         1,
+					Name:            "digitalocean-endpoint",
+					BucketName:      "bucket",
+					Domain:          "nyc3.digitaloceanspaces.com",
+					AccessKey:       "access",
+					SecretKey:       "secret",
+					Path:            "/logs/",
+					Period:          3600,
+					GzipLevel:       0,
+					MessageType:     "classic",
+					TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+					Format:          "%h %l %u %t \"%r\" %>s %b",
+					FormatVersion:   2,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":             "digitalocean-endpoint",
+					"bucket_name":      "bucket",
+					"domain":           "nyc3.digitaloceanspaces.com",
+					"access_key":       "access",
+					"secret_key":       "secret",
+					"path":             "/logs/",
+					"period":           uint(3600),
+					"gzip_level":       uint(0),
+					"message_type":     "classic",
+					"timestamp_format": "%Y-%m-%dT%H:%M:%S.000",
+					"format":           "%h %l %u %t \"%r\" %>s %b",
+					"format_version":   uint(2),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenDigitalOcean(c.remote)
+		if diff := cmp.Diff(out, c.local); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_logging_digitalocean_basic(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	log1 := gofastly.DigitalOcean{
+		Version:         1,
+		Name:            "digitalocean-endpoint",
+		BucketName:      "bucket",
+		Domain:          "nyc3.digitaloceanspaces.com",
+		AccessKey:       "s3cr3t",
+		SecretKey:       "s3cr3tkey",
+		Period:          3600,
+		GzipLevel:       0,
+		MessageType:     "classic",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b",
+		FormatVersion:   2,
+	}
+
+	log1_after_update := gofastly.DigitalOcean{
+		Version:         1,
+		Name:            "digitalocean-endpoint",
+		BucketName:      "new-bucket",
+		Domain:          "ams3.digitaloceanspaces.com",
+		AccessKey:       "secret",
+		SecretKey:       "secretkey",
+		Path:            "/new/",
+		Period:          3601,
+		GzipLevel:       1,
+		MessageType:     "blank",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b %T",
+		FormatVersion:   2,
+	}
+
+	log2 := gofastly.DigitalOcean{
+		Version:         1,
+		Name:            "another-digitalocean-endpoint",
+		BucketName:      "another-bucket",
+		Domain:          "nyc3.digitaloceanspaces.com",
+		AccessKey:       "another-access",
+		SecretKey:       "another-secret",
+		Period:          3600,
+		GzipLevel:       0,
+		MessageType:     "classic",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b",
+		FormatVersion:   2,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1DigitalOceanConfig(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1DigitalOceanAttributes(&service, []*gofastly.DigitalOcean{&log1}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_digitalocean.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccServiceV1DigitalOceanConfig_update(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1DigitalOceanAttributes(&service, []*gofastly.DigitalOcean{&log1_after_update, &log2}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_digitalocean.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1DigitalOceanAttributes(service *gofastly.ServiceDetail, digitalocean []*gofastly.DigitalOcean) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		digitaloceanList, err := conn.ListDigitalOceans(&gofastly.ListDigitalOceansInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up DigitalOcean Spaces Logging for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(digitaloceanList) != len(digitalocean) {
+			return fmt.Errorf("DigitalOcean Spaces List count mismatch, expected (%d), got (%d)", len(digitalocean), len(digitaloceanList))
+		}
+
+		log.Printf("[DEBUG] digitaloceanList = %#v\n", digitaloceanList)
+
+		for _, e := range digitalocean {
+			for _, el := range digitaloceanList {
+				if e.Name == el.Name {
+					// we don't know these things ahead of time, so populate them now
+					e.ServiceID = service.ID
+					e.Version = service.ActiveVersion.Number
+					// We don't track these, so clear them out because we also wont know
+					// these ahead of time
+					el.CreatedAt = nil
+					el.UpdatedAt = nil
+					if diff := cmp.Diff(e, el); diff != "" {
+						return fmt.Errorf("Bad match DigitalOcean Spaces logging match: %s", diff)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1DigitalOceanConfig(name string, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-digitalocean-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_digitalocean {
+    name        = "digitalocean-endpoint"
+    bucket_name = "bucket"
+    access_key  = "s3cr3t"
+    secret_key  = "s3cr3tkey"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
+
+func testAccServiceV1DigitalOceanConfig_update(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-digitalocean-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_digitalocean {
+    name         = "digitalocean-endpoint"
+    bucket_name  = "new-bucket"
+    domain       = "ams3.digitaloceanspaces.com"
+    access_key   = "secret"
+    secret_key   = "secretkey"
+    path         = "/new/"
+    period       = 3601
+    gzip_level   = 1
+    message_type = "blank"
+    format       = "%%h %%l %%u %%t \"%%r\" %%>s %%b %%T"
+  }
+
+  logging_digitalocean {
+    name        = "another-digitalocean-endpoint"
+    bucket_name = "another-bucket"
+    access_key  = "another-access"
+    secret_key  = "another-secret"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
@@ -45,7 +45,7 @@ func (h *ElasticSearchServiceAttributeHandler) Process(d *schema.ResourceData, l
 		log.Printf("[DEBUG] Fastly Elasticsearch logging endpoint removal opts: %#v", opts)
 
 		if err := deleteElasticsearch(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteElasticsearch", err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (h *ElasticSearchServiceAttributeHandler) Process(d *schema.ResourceData, l
 		log.Printf("[DEBUG] Fastly Elasticsearch logging addition opts: %#v", opts)
 
 		if err := createElasticsearch(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), ef["name"].(string), "CreateElasticsearch", err)
 		}
 	}
 
@@ -144,10 +144,11 @@ func (h *ElasticSearchServiceAttributeHandler) Register(s *schema.Resource) erro
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t \"%r\" %>s %b",
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t \"%r\" %>s %b",
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
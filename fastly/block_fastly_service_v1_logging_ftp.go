@@ -45,7 +45,7 @@ func (h *FTPServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 		log.Printf("[DEBUG] Fastly FTP logging endpoint removal opts: %#v", opts)
 
 		if err := deleteFTP(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteFTP", err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (h *FTPServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 		log.Printf("[DEBUG] Fastly FTP logging addition opts: %#v", opts)
 
 		if err := createFTP(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), ef["name"].(string), "CreateFTP", err)
 		}
 	}
 
@@ -158,9 +158,10 @@ func (h *FTPServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
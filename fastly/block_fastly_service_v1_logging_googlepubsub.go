@@ -60,9 +60,10 @@ func (h *GooglePubSubServiceAttributeHandler) Register(s *schema.Resource) error
 
 				// Optional
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache style log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache style log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
@@ -116,7 +117,7 @@ func (h *GooglePubSubServiceAttributeHandler) Process(d *schema.ResourceData, la
 		log.Printf("[DEBUG] Fastly Google Cloud Pub/Sub logging endpoint removal opts: %#v", opts)
 
 		if err := deleteGooglePubSub(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeletePubsub", err)
 		}
 	}
 
@@ -128,7 +129,7 @@ func (h *GooglePubSubServiceAttributeHandler) Process(d *schema.ResourceData, la
 		log.Printf("[DEBUG] Fastly Google Cloud Pub/Sub logging addition opts: %#v", opts)
 
 		if err := createGooglePubSub(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), cfg["name"].(string), "CreatePubsub", err)
 		}
 	}
 
@@ -45,7 +45,7 @@ func (h *HerokuServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Heroku logging endpoint removal opts: %#v", opts)
 
 		if err := deleteHeroku(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteHeroku", err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (h *HerokuServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Heroku logging addition opts: %#v", opts)
 
 		if err := createHeroku(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), lf["name"].(string), "CreateHeroku", err)
 		}
 	}
 
@@ -188,9 +188,10 @@ func (h *HerokuServiceAttributeHandler) Register(s *schema.Resource) error {
 
 				// Optional fields
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
@@ -0,0 +1,229 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceFastlyFlattenHoneycomb(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.Honeycomb
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.Honeycomb{
+				{
+					Version:           1,
+					Name:              "honeycomb-endpoint",
+					Token:             "token",
+					Dataset:           "dataset",
+					Placement:         "none",
+					ResponseCondition: "always",
+					Format:            "%h %l %u %t \"%r\" %>s %b",
+					FormatVersion:     2,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":               "honeycomb-endpoint",
+					"token":              "token",
+					"dataset":            "dataset",
+					"placement":          "none",
+					"format":             "%h %l %u %t \"%r\" %>s %b",
+					"response_condition": "always",
+					"format_version":     uint(2),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenHoneycomb(c.remote)
+		if diff := cmp.Diff(out, c.local); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_logging_honeycomb_basic(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	log1 := gofastly.Honeycomb{
+		Version:       1,
+		Name:          "honeycomb-endpoint",
+		Token:         "s3cr3t",
+		Dataset:       "dataset",
+		FormatVersion: 2,
+		Format:        "%h %l %u %t \"%r\" %>s %b",
+	}
+
+	log1_after_update := gofastly.Honeycomb{
+		Version:           1,
+		Name:              "honeycomb-endpoint",
+		Token:             "secret",
+		Dataset:           "new-dataset",
+		Placement:         "none",
+		ResponseCondition: "response_condition_test",
+		FormatVersion:     2,
+		Format:            "%h %l %u %t \"%r\" %>s %b %T",
+	}
+
+	log2 := gofastly.Honeycomb{
+		Version:       1,
+		Name:          "another-honeycomb-endpoint",
+		Token:         "another-token",
+		Dataset:       "another-dataset",
+		FormatVersion: 2,
+		Format:        "%h %l %u %t \"%r\" %>s %b",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1HoneycombConfig(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1HoneycombAttributes(&service, []*gofastly.Honeycomb{&log1}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_honeycomb.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccServiceV1HoneycombConfig_update(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1HoneycombAttributes(&service, []*gofastly.Honeycomb{&log1_after_update, &log2}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_honeycomb.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1HoneycombAttributes(service *gofastly.ServiceDetail, honeycomb []*gofastly.Honeycomb) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		honeycombList, err := conn.ListHoneycombs(&gofastly.ListHoneycombsInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up Honeycomb Logging for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(honeycombList) != len(honeycomb) {
+			return fmt.Errorf("Honeycomb List count mismatch, expected (%d), got (%d)", len(honeycomb), len(honeycombList))
+		}
+
+		log.Printf("[DEBUG] honeycombList = %#v\n", honeycombList)
+
+		for _, e := range honeycomb {
+			for _, el := range honeycombList {
+				if e.Name == el.Name {
+					// we don't know these things ahead of time, so populate them now
+					e.ServiceID = service.ID
+					e.Version = service.ActiveVersion.Number
+					// We don't track these, so clear them out because we also wont know
+					// these ahead of time
+					el.CreatedAt = nil
+					el.UpdatedAt = nil
+					if diff := cmp.Diff(e, el); diff != "" {
+						return fmt.Errorf("Bad match Honeycomb logging match: %s", diff)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1HoneycombConfig(name string, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-honeycomb-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_honeycomb {
+    name    = "honeycomb-endpoint"
+    token   = "s3cr3t"
+    dataset = "dataset"
+    format  = "%%h %%l %%u %%t \"%%r\" %%>s %%b"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
+
+func testAccServiceV1HoneycombConfig_update(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-honeycomb-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  condition {
+    name      = "response_condition_test"
+    type      = "RESPONSE"
+    priority  = 8
+    statement = "resp.status == 418"
+  }
+
+  logging_honeycomb {
+    name               = "honeycomb-endpoint"
+    token              = "secret"
+    dataset            = "new-dataset"
+    placement          = "none"
+    format             = "%%h %%l %%u %%t \"%%r\" %%>s %%b %%T"
+    response_condition = "response_condition_test"
+  }
+
+  logging_honeycomb {
+    name    = "another-honeycomb-endpoint"
+    token   = "another-token"
+    dataset = "another-dataset"
+    format  = "%%h %%l %%u %%t \"%%r\" %%>s %%b"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
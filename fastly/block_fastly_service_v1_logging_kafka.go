@@ -54,8 +54,8 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"required_acks": {
-					Type:     schema.TypeString,
-					Optional: true,
+					Type:        schema.TypeString,
+					Optional:    true,
 					Description: "The Number of acknowledgements a leader must receive before a write is considered successful. One of: 1 (default) One server needs to respond. 0 No servers need to respond. -1	Wait for all in-sync replicas to respond.",
 				},
 
@@ -100,9 +100,10 @@ func (h *KafkaServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache style log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache style log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
@@ -156,7 +157,7 @@ func (h *KafkaServiceAttributeHandler) Process(d *schema.ResourceData, latestVer
 		log.Printf("[DEBUG] Fastly Kafka logging endpoint removal opts: %#v", opts)
 
 		if err := deleteKafka(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteKafka", err)
 		}
 	}
 
@@ -183,7 +184,7 @@ func (h *KafkaServiceAttributeHandler) Process(d *schema.ResourceData, latestVer
 		log.Printf("[DEBUG] Fastly Kafka logging addition opts: %#v", opts)
 
 		if err := createKafka(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), cfg["name"].(string), "CreateKafka", err)
 		}
 	}
 
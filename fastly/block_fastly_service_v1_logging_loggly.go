@@ -45,7 +45,7 @@ func (h *LogglyServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Loggly logging endpoint removal opts: %#v", opts)
 
 		if err := deleteLoggly(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteLoggly", err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (h *LogglyServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Loggly logging addition opts: %#v", opts)
 
 		if err := createLoggly(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), lf["name"].(string), "CreateLoggly", err)
 		}
 	}
 
@@ -179,9 +179,10 @@ func (h *LogglyServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				// Optional fields
@@ -45,7 +45,7 @@ func (h *NewRelicServiceAttributeHandler) Process(d *schema.ResourceData, latest
 		log.Printf("[DEBUG] Fastly New Relic logging endpoint removal opts: %#v", opts)
 
 		if err := deleteNewRelic(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteNewRelic", err)
 		}
 	}
 
@@ -57,7 +57,7 @@ func (h *NewRelicServiceAttributeHandler) Process(d *schema.ResourceData, latest
 		log.Printf("[DEBUG] Fastly New Relic logging addition opts: %#v", opts)
 
 		if err := createNewRelic(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), df["name"].(string), "CreateNewRelic", err)
 		}
 	}
 
@@ -178,9 +178,10 @@ func (h *NewRelicServiceAttributeHandler) Register(s *schema.Resource) error {
 
 				// Optional fields
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache style log formatting. Your log must produce valid JSON that New Relic Logs can ingest.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache style log formatting. Your log must produce valid JSON that New Relic Logs can ingest.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
@@ -0,0 +1,255 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestResourceFastlyFlattenOpenStack(t *testing.T) {
+	cases := []struct {
+		remote []*gofastly.Openstack
+		local  []map[string]interface{}
+	}{
+		{
+			remote: []*gofastly.Openstack{
+				{
+					Version:         1,
+					Name:            "openstack-endpoint",
+					URL:             "https://auth.example.com/v1",
+					User:            "user",
+					BucketName:      "bucket",
+					AccessKey:       "secret",
+					Path:            "/logs/",
+					Period:          3600,
+					GzipLevel:       0,
+					MessageType:     "classic",
+					TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+					Format:          "%h %l %u %t \"%r\" %>s %b",
+					FormatVersion:   2,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":             "openstack-endpoint",
+					"url":              "https://auth.example.com/v1",
+					"user":             "user",
+					"bucket_name":      "bucket",
+					"access_key":       "secret",
+					"path":             "/logs/",
+					"period":           uint(3600),
+					"gzip_level":       uint(0),
+					"message_type":     "classic",
+					"timestamp_format": "%Y-%m-%dT%H:%M:%S.000",
+					"format":           "%h %l %u %t \"%r\" %>s %b",
+					"format_version":   uint(2),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		out := flattenOpenStack(c.remote)
+		if diff := cmp.Diff(out, c.local); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
+
+func TestAccFastlyServiceV1_logging_openstack_basic(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.%s.com", name)
+
+	log1 := gofastly.Openstack{
+		Version:         1,
+		Name:            "openstack-endpoint",
+		URL:             "https://auth.example.com/v1",
+		User:            "user",
+		BucketName:      "bucket",
+		AccessKey:       "s3cr3t",
+		Period:          3600,
+		GzipLevel:       0,
+		MessageType:     "classic",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b",
+		FormatVersion:   2,
+	}
+
+	log1_after_update := gofastly.Openstack{
+		Version:         1,
+		Name:            "openstack-endpoint",
+		URL:             "https://auth.example.com/v2",
+		User:            "new-user",
+		BucketName:      "new-bucket",
+		AccessKey:       "secret",
+		Path:            "/new/",
+		Period:          3601,
+		GzipLevel:       1,
+		MessageType:     "blank",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b %T",
+		FormatVersion:   2,
+	}
+
+	log2 := gofastly.Openstack{
+		Version:         1,
+		Name:            "another-openstack-endpoint",
+		URL:             "https://auth.example.com/v1",
+		User:            "another-user",
+		BucketName:      "another-bucket",
+		AccessKey:       "another-secret",
+		Period:          3600,
+		GzipLevel:       0,
+		MessageType:     "classic",
+		TimestampFormat: "%Y-%m-%dT%H:%M:%S.000",
+		Format:          "%h %l %u %t \"%r\" %>s %b",
+		FormatVersion:   2,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1OpenStackConfig(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1OpenStackAttributes(&service, []*gofastly.Openstack{&log1}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_openstack.#", "1"),
+				),
+			},
+
+			{
+				Config: testAccServiceV1OpenStackConfig_update(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1OpenStackAttributes(&service, []*gofastly.Openstack{&log1_after_update, &log2}),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "logging_openstack.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyServiceV1OpenStackAttributes(service *gofastly.ServiceDetail, openstack []*gofastly.Openstack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		openstackList, err := conn.ListOpenstack(&gofastly.ListOpenstackInput{
+			Service: service.ID,
+			Version: service.ActiveVersion.Number,
+		})
+
+		if err != nil {
+			return fmt.Errorf("[ERR] Error looking up OpenStack Logging for (%s), version (%d): %s", service.Name, service.ActiveVersion.Number, err)
+		}
+
+		if len(openstackList) != len(openstack) {
+			return fmt.Errorf("OpenStack List count mismatch, expected (%d), got (%d)", len(openstack), len(openstackList))
+		}
+
+		log.Printf("[DEBUG] openstackList = %#v\n", openstackList)
+
+		for _, e := range openstack {
+			for _, el := range openstackList {
+				if e.Name == el.Name {
+					// we don't know these things ahead of time, so populate them now
+					e.ServiceID = service.ID
+					e.Version = service.ActiveVersion.Number
+					// We don't track these, so clear them out because we also wont know
+					// these ahead of time
+					el.CreatedAt = nil
+					el.UpdatedAt = nil
+					if diff := cmp.Diff(e, el); diff != "" {
+						return fmt.Errorf("Bad match OpenStack logging match: %s", diff)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccServiceV1OpenStackConfig(name string, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-openstack-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_openstack {
+    name        = "openstack-endpoint"
+    url         = "https://auth.example.com/v1"
+    user        = "user"
+    bucket_name = "bucket"
+    access_key  = "s3cr3t"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
+
+func testAccServiceV1OpenStackConfig_update(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-openstack-logging"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  logging_openstack {
+    name         = "openstack-endpoint"
+    url          = "https://auth.example.com/v2"
+    user         = "new-user"
+    bucket_name  = "new-bucket"
+    access_key   = "secret"
+    path         = "/new/"
+    period       = 3601
+    gzip_level   = 1
+    message_type = "blank"
+    format       = "%%h %%l %%u %%t \"%%r\" %%>s %%b %%T"
+  }
+
+  logging_openstack {
+    name        = "another-openstack-endpoint"
+    url         = "https://auth.example.com/v1"
+    user        = "another-user"
+    bucket_name = "another-bucket"
+    access_key  = "another-secret"
+  }
+
+  force_destroy = true
+}
+`, name, domain)
+}
@@ -49,9 +49,10 @@ func (h *ScalyrServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Description: "Apache style log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Apache style log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
@@ -105,7 +106,7 @@ func (h *ScalyrServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Scalyr logging endpoint removal opts: %#v", opts)
 
 		if err := deleteScalyr(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteScalyr", err)
 		}
 	}
 
@@ -117,7 +118,7 @@ func (h *ScalyrServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Fastly Scalyr logging addition opts: %#v", opts)
 
 		if err := createScalyr(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), cfg["name"].(string), "CreateScalyr", err)
 		}
 	}
 
@@ -118,10 +118,11 @@ func (h *SFTPServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t \"%r\" %>s %b",
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t \"%r\" %>s %b",
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 
 				"format_version": {
@@ -175,7 +176,7 @@ func (h *SFTPServiceAttributeHandler) Process(d *schema.ResourceData, latestVers
 		log.Printf("[DEBUG] Fastly SFTP logging endpoint removal opts: %#v", opts)
 
 		if err := deleteSFTP(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteSFTP", err)
 		}
 	}
 
@@ -206,7 +207,7 @@ func (h *SFTPServiceAttributeHandler) Process(d *schema.ResourceData, latestVers
 		log.Printf("[DEBUG] Fastly SFTP logging addition opts: %#v", opts)
 
 		if err := createSFTP(conn, opts); err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), sf["name"].(string), "CreateSFTP", err)
 		}
 	}
 
@@ -47,10 +47,10 @@ func (h *PaperTrailServiceAttributeHandler) Process(d *schema.ResourceData, late
 		err := conn.DeletePapertrail(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeletePapertrail", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeletePapertrail", err)
 		}
 	}
 
@@ -72,7 +72,7 @@ func (h *PaperTrailServiceAttributeHandler) Process(d *schema.ResourceData, late
 		log.Printf("[DEBUG] Create Papertrail Opts: %#v", opts)
 		_, err := conn.CreatePapertrail(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreatePapertrail", err)
 		}
 	}
 
@@ -123,10 +123,11 @@ func (h *PaperTrailServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 				// Optional fields
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t %r %>s",
-					Description: "Apache-style string or VCL variables to use for log formatting",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t %r %>s",
+					Description:  "Apache-style string or VCL variables to use for log formatting",
+					ValidateFunc: validateLogFormat(),
 				},
 				"response_condition": {
 					Type:        schema.TypeString,
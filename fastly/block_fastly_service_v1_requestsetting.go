@@ -48,10 +48,10 @@ func (h *RequestSettingServiceAttributeHandler) Process(d *schema.ResourceData,
 		err := conn.DeleteRequestSetting(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteRequestSetting", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteRequestSetting", err)
 		}
 	}
 
@@ -68,7 +68,7 @@ func (h *RequestSettingServiceAttributeHandler) Process(d *schema.ResourceData,
 		log.Printf("[DEBUG] Create Request Setting Opts: %#v", opts)
 		_, err = conn.CreateRequestSetting(opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateRequestSetting", err)
 		}
 	}
 
@@ -116,6 +116,7 @@ func (h *RequestSettingServiceAttributeHandler) Register(s *schema.Resource) err
 				"max_stale_age": {
 					Type:        schema.TypeInt,
 					Optional:    true,
+					Default:     60,
 					Description: "How old an object is allowed to be, in seconds. Default `60`",
 				},
 				"force_miss": {
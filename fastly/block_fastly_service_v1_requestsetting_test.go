@@ -44,6 +44,29 @@ func TestResourceFastlyFlattenRequestSettings(t *testing.T) {
 				},
 			},
 		},
+		{
+			// An explicit zero max_stale_age must survive the round-trip and
+			// not be pruned along with the empty strings.
+			remote: []*gofastly.RequestSetting{
+				{
+					Name:        "no_stale",
+					MaxStaleAge: 0,
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":             "no_stale",
+					"max_stale_age":    uint(0),
+					"action":           gofastly.RequestSettingAction(""),
+					"xff":              gofastly.RequestSettingXFF(""),
+					"bypass_busy_wait": false,
+					"force_miss":       false,
+					"force_ssl":        false,
+					"geo_headers":      false,
+					"timer_support":    false,
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -3,6 +3,8 @@ package fastly
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -47,10 +49,10 @@ func (h *ResponseObjectServiceAttributeHandler) Process(d *schema.ResourceData,
 		err := conn.DeleteResponseObject(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteResponseObject", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteResponseObject", err)
 		}
 	}
 
@@ -73,7 +75,7 @@ func (h *ResponseObjectServiceAttributeHandler) Process(d *schema.ResourceData,
 		log.Printf("[DEBUG] Create Response Object Opts: %#v", opts)
 		_, err := conn.CreateResponseObject(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateResponseObject", err)
 		}
 	}
 	return nil
@@ -179,3 +181,42 @@ func flattenResponseObjects(responseObjectList []*gofastly.ResponseObject) []map
 
 	return rol
 }
+
+// responseObjectConditionWarnings flags response objects that share a
+// request_condition or cache_condition. Fastly does not define which object
+// is served when several match, so sharing a condition is almost always a
+// configuration mistake.
+func responseObjectConditionWarnings(responseObjects []interface{}) []string {
+	var warnings []string
+	seen := map[string][]string{}
+	for _, roRaw := range responseObjects {
+		ro := roRaw.(map[string]interface{})
+		for _, conditionKey := range []string{"request_condition", "cache_condition"} {
+			if c, _ := ro[conditionKey].(string); c != "" {
+				seen[conditionKey+"/"+c] = append(seen[conditionKey+"/"+c], ro["name"].(string))
+			}
+		}
+	}
+	for key, names := range seen {
+		if len(names) > 1 {
+			sort.Strings(names)
+			parts := strings.SplitN(key, "/", 2)
+			warnings = append(warnings, fmt.Sprintf("response objects %s share %s %q; Fastly does not define which one is served when the condition matches, so give each response object its own condition", strings.Join(names, ", "), parts[0], parts[1]))
+		}
+	}
+	sort.Strings(warnings)
+	return warnings
+}
+
+// warnResponseObjectSharedConditions surfaces responseObjectConditionWarnings
+// at plan time.
+func warnResponseObjectSharedConditions(d *schema.ResourceDiff, _ interface{}) error {
+	responseObjects, ok := d.Get("response_object").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	for _, w := range responseObjectConditionWarnings(responseObjects.List()) {
+		log.Printf("[WARN] %s", w)
+	}
+	return nil
+}
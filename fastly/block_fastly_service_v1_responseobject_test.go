@@ -265,3 +265,53 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, domain)
 }
+
+func TestResponseObjectConditionWarnings(t *testing.T) {
+	cases := []struct {
+		name            string
+		responseObjects []interface{}
+		expectedWarns   int
+	}{
+		{
+			name: "distinct conditions",
+			responseObjects: []interface{}{
+				map[string]interface{}{"name": "a", "request_condition": "one", "cache_condition": ""},
+				map[string]interface{}{"name": "b", "request_condition": "two", "cache_condition": ""},
+			},
+			expectedWarns: 0,
+		},
+		{
+			name: "shared request condition",
+			responseObjects: []interface{}{
+				map[string]interface{}{"name": "a", "request_condition": "shared", "cache_condition": ""},
+				map[string]interface{}{"name": "b", "request_condition": "shared", "cache_condition": ""},
+			},
+			expectedWarns: 1,
+		},
+		{
+			name: "empty conditions are not shared",
+			responseObjects: []interface{}{
+				map[string]interface{}{"name": "a", "request_condition": "", "cache_condition": ""},
+				map[string]interface{}{"name": "b", "request_condition": "", "cache_condition": ""},
+			},
+			expectedWarns: 0,
+		},
+		{
+			name: "shared request and cache conditions",
+			responseObjects: []interface{}{
+				map[string]interface{}{"name": "a", "request_condition": "shared", "cache_condition": "cached"},
+				map[string]interface{}{"name": "b", "request_condition": "shared", "cache_condition": "cached"},
+			},
+			expectedWarns: 2,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			warns := responseObjectConditionWarnings(c.responseObjects)
+			if len(warns) != c.expectedWarns {
+				t.Errorf("expected %d warnings, got %d: %v", c.expectedWarns, len(warns), warns)
+			}
+		})
+	}
+}
@@ -42,7 +42,7 @@ func (h *S3LoggingServiceAttributeHandler) Process(d *schema.ResourceData, lates
 		opts := buildDeleteS3(sRaw, serviceID, latestVersion)
 		err := deleteS3(conn, opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteS3", err)
 		}
 	}
 
@@ -51,7 +51,7 @@ func (h *S3LoggingServiceAttributeHandler) Process(d *schema.ResourceData, lates
 		opts, _ := buildCreateS3(sRaw, d.Id(), latestVersion)
 		err := createS3(conn, opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateS3", err)
 		}
 	}
 	return nil
@@ -133,10 +133,11 @@ func (h *S3LoggingServiceAttributeHandler) Register(s *schema.Resource) error {
 					Description: "How frequently the logs should be transferred, in seconds (Default 3600).",
 				},
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t %r %>s",
-					Description: "Apache-style string or VCL variables to use for log formatting.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t %r %>s",
+					Description:  "Apache-style string or VCL variables to use for log formatting.",
+					ValidateFunc: validateLogFormat(),
 				},
 				"format_version": {
 					Type:         schema.TypeInt,
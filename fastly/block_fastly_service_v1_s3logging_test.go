@@ -162,6 +162,19 @@ func TestAccFastlyServiceV1_s3logging_basic(t *testing.T) {
 						"fastly_service_v1.foo", "s3logging.#", "2"),
 				),
 			},
+
+			{
+				ResourceName:      "fastly_service_v1.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Config-only fields cannot be recovered from the API.
+				ImportStateVerifyIgnore: []string{
+					"activate",
+					"force_destroy",
+					"version_clone_timeout",
+					"version_clone_poll_interval",
+				},
+			},
 		},
 	})
 }
@@ -14,6 +14,12 @@ func NewServiceSettings() ServiceAttributeDefinition {
 	return &SettingsServiceAttributeHandler{}
 }
 
+// GetKey reports the settings block; the handler manages top-level attributes
+// rather than its own block, so this is only used for error context.
+func (h *SettingsServiceAttributeHandler) GetKey() string {
+	return "settings"
+}
+
 func (h *SettingsServiceAttributeHandler) Process(d *schema.ResourceData, latestVersion int, conn *gofastly.Client) error {
 	opts := gofastly.UpdateSettingsInput{
 		Service: d.Id(),
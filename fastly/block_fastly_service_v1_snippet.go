@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
@@ -51,14 +52,15 @@ func (h *SnippetServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 		err := conn.DeleteSnippet(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteSnippet", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteSnippet", err)
 		}
 	}
 
 	// POST new VCL Snippet configurations
+	sortSnippets(add)
 	for _, dRaw := range add {
 		opts, err := buildSnippet(dRaw.(map[string]interface{}))
 		if err != nil {
@@ -71,7 +73,7 @@ func (h *SnippetServiceAttributeHandler) Process(d *schema.ResourceData, latestV
 		log.Printf("[DEBUG] Fastly VCL Snippet Addition opts: %#v", opts)
 		_, err = conn.CreateSnippet(opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateSnippet", err)
 		}
 	}
 	return nil
@@ -113,9 +115,10 @@ func (h *SnippetServiceAttributeHandler) Register(s *schema.Resource) error {
 					ValidateFunc: validateSnippetType(),
 				},
 				"content": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The contents of the VCL snippet",
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The contents of the VCL snippet",
+					ValidateFunc: validateContentSize(maximumVCLSizeBytes),
 				},
 				"priority": {
 					Type:        schema.TypeInt,
@@ -129,6 +132,24 @@ func (h *SnippetServiceAttributeHandler) Register(s *schema.Resource) error {
 	return nil
 }
 
+// sortSnippets orders snippet elements by (type, priority, name) so that
+// snippets are always created in the same order. Set iteration order is
+// otherwise unspecified, and within a type the relative creation order of
+// equal-priority snippets is what the API falls back on.
+func sortSnippets(snippets []interface{}) {
+	sort.SliceStable(snippets, func(i, j int) bool {
+		a := snippets[i].(map[string]interface{})
+		b := snippets[j].(map[string]interface{})
+		if a["type"].(string) != b["type"].(string) {
+			return a["type"].(string) < b["type"].(string)
+		}
+		if a["priority"].(int) != b["priority"].(int) {
+			return a["priority"].(int) < b["priority"].(int)
+		}
+		return a["name"].(string) < b["name"].(string)
+	})
+}
+
 func buildSnippet(snippetMap interface{}) (*gofastly.CreateSnippetInput, error) {
 	df := snippetMap.(map[string]interface{})
 	opts := gofastly.CreateSnippetInput{
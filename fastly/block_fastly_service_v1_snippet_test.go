@@ -228,3 +228,24 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, domain)
 }
+
+func TestResourceFastlySortSnippets(t *testing.T) {
+	snippets := []interface{}{
+		map[string]interface{}{"name": "b", "type": "recv", "priority": 100},
+		map[string]interface{}{"name": "a", "type": "deliver", "priority": 100},
+		map[string]interface{}{"name": "a", "type": "recv", "priority": 100},
+		map[string]interface{}{"name": "c", "type": "recv", "priority": 50},
+	}
+
+	want := []interface{}{
+		map[string]interface{}{"name": "a", "type": "deliver", "priority": 100},
+		map[string]interface{}{"name": "c", "type": "recv", "priority": 50},
+		map[string]interface{}{"name": "a", "type": "recv", "priority": 100},
+		map[string]interface{}{"name": "b", "type": "recv", "priority": 100},
+	}
+
+	sortSnippets(snippets)
+	if !reflect.DeepEqual(snippets, want) {
+		t.Fatalf("Error matching:\nexpected: %#v\n got: %#v", want, snippets)
+	}
+}
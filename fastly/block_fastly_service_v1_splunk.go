@@ -48,10 +48,10 @@ func (h *SplunkServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		err := conn.DeleteSplunk(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteSplunk", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteSplunk", err)
 		}
 	}
 
@@ -90,7 +90,7 @@ func (h *SplunkServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Splunk create opts: %#v", opts)
 		_, err := conn.CreateSplunk(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateSplunk", err)
 		}
 	}
 	return nil
@@ -141,10 +141,11 @@ func (h *SplunkServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 				// Optional fields
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t \"%r\" %>s %b",
-					Description: "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t \"%r\" %>s %b",
+					Description:  "Apache-style string or VCL variables to use for log formatting (default: `%h %l %u %t \"%r\" %>s %b`)",
+					ValidateFunc: validateLogFormat(),
 				},
 				"format_version": {
 					Type:         schema.TypeInt,
@@ -47,10 +47,10 @@ func (h *SumologicServiceAttributeHandler) Process(d *schema.ResourceData, lates
 		err := conn.DeleteSumologic(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteSumologic", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteSumologic", err)
 		}
 	}
 
@@ -72,7 +72,7 @@ func (h *SumologicServiceAttributeHandler) Process(d *schema.ResourceData, lates
 		log.Printf("[DEBUG] Create Sumologic Opts: %#v", opts)
 		_, err := conn.CreateSumologic(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateSumologic", err)
 		}
 	}
 	return nil
@@ -115,10 +115,11 @@ func (h *SumologicServiceAttributeHandler) Register(s *schema.Resource) error {
 				},
 				// Optional fields
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t %r %>s",
-					Description: "Apache-style string or VCL variables to use for log formatting",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t %r %>s",
+					Description:  "Apache-style string or VCL variables to use for log formatting",
+					ValidateFunc: validateLogFormat(),
 				},
 				"format_version": {
 					Type:         schema.TypeInt,
@@ -47,10 +47,10 @@ func (h *SyslogServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		err := conn.DeleteSyslog(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteSyslog", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteSyslog", err)
 		}
 	}
 
@@ -80,7 +80,7 @@ func (h *SyslogServiceAttributeHandler) Process(d *schema.ResourceData, latestVe
 		log.Printf("[DEBUG] Create Syslog Opts: %#v", opts)
 		_, err := conn.CreateSyslog(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateSyslog", err)
 		}
 	}
 	return nil
@@ -130,10 +130,11 @@ func (h *SyslogServiceAttributeHandler) Register(s *schema.Resource) error {
 					Description: "The port of the syslog service",
 				},
 				"format": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "%h %l %u %t \"%r\" %>s %b",
-					Description: "Apache-style string or VCL variables to use for log formatting",
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "%h %l %u %t \"%r\" %>s %b",
+					Description:  "Apache-style string or VCL variables to use for log formatting",
+					ValidateFunc: validateLogFormat(),
 				},
 				"format_version": {
 					Type:         schema.TypeInt,
@@ -237,3 +238,27 @@ func flattenSyslogs(syslogList []*gofastly.Syslog) []map[string]interface{} {
 
 	return pl
 }
+
+// checkSyslogTLSClientPairs errors when a syslog endpoint sets only one of
+// tls_client_cert and tls_client_key; mutual TLS needs both, and the API
+// accepts the half-configured endpoint only to fail at connection time.
+func checkSyslogTLSClientPairs(syslogs []interface{}) error {
+	for _, sRaw := range syslogs {
+		sf := sRaw.(map[string]interface{})
+		cert, _ := sf["tls_client_cert"].(string)
+		key, _ := sf["tls_client_key"].(string)
+		if (cert == "") != (key == "") {
+			return fmt.Errorf("syslog '%s' must set tls_client_cert and tls_client_key together", sf["name"].(string))
+		}
+	}
+	return nil
+}
+
+// validateSyslogTLSClientPairs surfaces checkSyslogTLSClientPairs at plan time.
+func validateSyslogTLSClientPairs(d *schema.ResourceDiff, _ interface{}) error {
+	syslogs, ok := d.Get("syslog").(*schema.Set)
+	if !ok {
+		return nil
+	}
+	return checkSyslogTLSClientPairs(syslogs.List())
+}
@@ -140,6 +140,19 @@ func TestAccFastlyServiceV1_syslog_basic(t *testing.T) {
 						"fastly_service_v1.foo", "syslog.#", "2"),
 				),
 			},
+
+			{
+				ResourceName:      "fastly_service_v1.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Config-only fields cannot be recovered from the API.
+				ImportStateVerifyIgnore: []string{
+					"activate",
+					"force_destroy",
+					"version_clone_timeout",
+					"version_clone_poll_interval",
+				},
+			},
 		},
 	})
 }
@@ -423,3 +436,50 @@ func getSyslogEnv() *currentSyslogEnv {
 		ClientKey:  os.Getenv("FASTLY_SYSLOG_CLIENT_KEY"),
 	}
 }
+
+func TestCheckSyslogTLSClientPairs(t *testing.T) {
+	cases := []struct {
+		name        string
+		syslogs     []interface{}
+		expectError bool
+	}{
+		{
+			name: "neither set",
+			syslogs: []interface{}{
+				map[string]interface{}{"name": "a", "tls_client_cert": "", "tls_client_key": ""},
+			},
+		},
+		{
+			name: "both set",
+			syslogs: []interface{}{
+				map[string]interface{}{"name": "a", "tls_client_cert": "cert", "tls_client_key": "key"},
+			},
+		},
+		{
+			name: "cert without key",
+			syslogs: []interface{}{
+				map[string]interface{}{"name": "a", "tls_client_cert": "cert", "tls_client_key": ""},
+			},
+			expectError: true,
+		},
+		{
+			name: "key without cert",
+			syslogs: []interface{}{
+				map[string]interface{}{"name": "a", "tls_client_cert": "", "tls_client_key": "key"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkSyslogTLSClientPairs(c.syslogs)
+			if c.expectError && err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+			if !c.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -51,10 +51,10 @@ func (h *VCLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 		err := conn.DeleteVCL(&opts)
 		if errRes, ok := err.(*gofastly.HTTPError); ok {
 			if errRes.StatusCode != 404 {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "DeleteVCL", err)
 			}
 		} else if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "DeleteVCL", err)
 		}
 	}
 	// POST new VCL configurations
@@ -70,7 +70,7 @@ func (h *VCLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 		log.Printf("[DEBUG] Fastly VCL Addition opts: %#v", opts)
 		_, err := conn.CreateVCL(&opts)
 		if err != nil {
-			return err
+			return wrapBlockError(h.GetKey(), opts.Name, "CreateVCL", err)
 		}
 
 		// if this new VCL is the main
@@ -83,7 +83,7 @@ func (h *VCLServiceAttributeHandler) Process(d *schema.ResourceData, latestVersi
 			log.Printf("[DEBUG] Fastly VCL activation opts: %#v", opts)
 			_, err := conn.ActivateVCL(&opts)
 			if err != nil {
-				return err
+				return wrapBlockError(h.GetKey(), opts.Name, "ActivateVCL", err)
 			}
 
 		}
@@ -121,9 +121,10 @@ func (h *VCLServiceAttributeHandler) Register(s *schema.Resource) error {
 					Description: "A name to refer to this VCL configuration",
 				},
 				"content": {
-					Type:        schema.TypeString,
-					Required:    true,
-					Description: "The contents of this VCL configuration",
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The contents of this VCL configuration",
+					ValidateFunc: validateContentSize(maximumVCLSizeBytes),
 				},
 				"main": {
 					Type:        schema.TypeBool,
@@ -189,3 +189,148 @@ EOF
   force_destroy = true
 }`, name, domain)
 }
+
+func TestAccFastlyServiceV1_fullVCL(t *testing.T) {
+	var service gofastly.ServiceDetail
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domainName := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceV1FullVCLConfig(name, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceV1Exists("fastly_service_v1.foo", &service),
+					testAccCheckFastlyServiceV1VCLAttributes(&service, name, 1),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "vcl.#", "1"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "snippet.#", "2"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "condition.#", "2"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "header.#", "2"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "cache_setting.#", "1"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "request_setting.#", "1"),
+					resource.TestCheckResourceAttr(
+						"fastly_service_v1.foo", "response_object.#", "1"),
+				),
+			},
+
+			// A refresh of the same config should produce an empty plan, so any
+			// spurious diff from the flatten/prune logic fails the test here.
+			{
+				Config:   testAccServiceV1FullVCLConfig(name, domainName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccServiceV1FullVCLConfig(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  vcl {
+    name    = "my_custom_main_vcl"
+    content = <<EOF
+sub vcl_recv {
+#FASTLY recv
+
+    if (req.request != "HEAD" && req.request != "GET" && req.request != "FASTLYPURGE") {
+      return(pass);
+    }
+
+    return(lookup);
+}
+EOF
+    main    = true
+  }
+
+  snippet {
+    name     = "recv_snippet"
+    type     = "recv"
+    priority = 110
+    content  = "# recv snippet"
+  }
+
+  snippet {
+    name     = "deliver_snippet"
+    type     = "deliver"
+    priority = 110
+    content  = "# deliver snippet"
+  }
+
+  condition {
+    name      = "request_condition_test"
+    type      = "REQUEST"
+    priority  = 10
+    statement = "req.url ~ \"^/api\""
+  }
+
+  condition {
+    name      = "cache_condition_test"
+    type      = "CACHE"
+    priority  = 10
+    statement = "req.url ~ \"^/static\""
+  }
+
+  header {
+    name        = "request header"
+    destination = "http.X-Request"
+    type        = "request"
+    action      = "set"
+    source      = "\"tf-request\""
+
+    request_condition = "request_condition_test"
+  }
+
+  header {
+    name        = "response header"
+    destination = "http.X-Response"
+    type        = "response"
+    action      = "set"
+    source      = "\"tf-response\""
+  }
+
+  cache_setting {
+    name            = "cache_setting_test"
+    action          = "cache"
+    ttl             = 300
+    cache_condition = "cache_condition_test"
+  }
+
+  request_setting {
+    name              = "request_setting_test"
+    default_host      = "aws.amazon.com"
+    request_condition = "request_condition_test"
+  }
+
+  response_object {
+    name              = "response_object_test"
+    status            = 418
+    response          = "I am a teapot"
+    content           = "short and stout"
+    content_type      = "text/plain"
+    request_condition = "request_condition_test"
+  }
+
+  force_destroy = true
+}`, name, domain)
+}
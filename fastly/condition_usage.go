@@ -0,0 +1,96 @@
+package fastly
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// conditionFieldsByBlock lists, for each block that can reference a
+// condition, the sub-fields holding the condition name.
+var conditionFieldsByBlock = map[string][]string{
+	"header":                {"request_condition", "cache_condition", "response_condition"},
+	"request_setting":       {"request_condition"},
+	"cache_setting":         {"cache_condition"},
+	"gzip":                  {"cache_condition"},
+	"response_object":       {"request_condition", "cache_condition"},
+	"backend":               {"request_condition"},
+	"s3logging":             {"response_condition"},
+	"papertrail":            {"response_condition"},
+	"sumologic":             {"response_condition"},
+	"gcslogging":            {"response_condition"},
+	"bigquerylogging":       {"response_condition"},
+	"syslog":                {"response_condition"},
+	"logentries":            {"response_condition"},
+	"splunk":                {"response_condition"},
+	"blobstoragelogging":    {"response_condition"},
+	"httpslogging":          {"response_condition"},
+	"logging_elasticsearch": {"response_condition"},
+	"logging_ftp":           {"response_condition"},
+	"logging_sftp":          {"response_condition"},
+	"logging_datadog":       {"response_condition"},
+	"logging_loggly":        {"response_condition"},
+	"logging_googlepubsub":  {"response_condition"},
+	"logging_scalyr":        {"response_condition"},
+	"logging_newrelic":      {"response_condition"},
+	"logging_kafka":         {"response_condition"},
+	"logging_heroku":        {"response_condition"},
+	"logging_honeycomb":     {"response_condition"},
+	"logging_logshuttle":    {"response_condition"},
+	"logging_openstack":     {"response_condition"},
+	"logging_cloudfiles":    {"response_condition"},
+	"logging_digitalocean":  {"response_condition"},
+}
+
+// buildConditionUsage maps each referenced condition name to a sorted,
+// comma-separated list of "blockType/name" strings describing the block
+// elements that reference it.
+func buildConditionUsage(blocks map[string][]interface{}) map[string]interface{} {
+	users := make(map[string][]string)
+
+	for blockKey, elements := range blocks {
+		fields, ok := conditionFieldsByBlock[blockKey]
+		if !ok {
+			continue
+		}
+
+		for _, eRaw := range elements {
+			element, ok := eRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name, _ := element["name"].(string)
+			for _, field := range fields {
+				if condition, _ := element[field].(string); condition != "" {
+					users[condition] = append(users[condition], blockKey+"/"+name)
+				}
+			}
+		}
+	}
+
+	usage := make(map[string]interface{}, len(users))
+	for condition, refs := range users {
+		sort.Strings(refs)
+		usage[condition] = strings.Join(refs, ",")
+	}
+
+	return usage
+}
+
+// readConditionUsage assembles the condition usage report from the freshly
+// refreshed block state and stores it in the computed condition_usage
+// attribute.
+func readConditionUsage(d *schema.ResourceData) error {
+	blocks := make(map[string][]interface{})
+	for blockKey := range conditionFieldsByBlock {
+		if v, ok := d.GetOk(blockKey); ok {
+			if s, ok := v.(*schema.Set); ok {
+				blocks[blockKey] = s.List()
+			}
+		}
+	}
+
+	return d.Set("condition_usage", buildConditionUsage(blocks))
+}
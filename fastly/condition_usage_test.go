@@ -0,0 +1,63 @@
+package fastly
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResourceFastlyBuildConditionUsage(t *testing.T) {
+	cases := []struct {
+		blocks   map[string][]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			blocks: map[string][]interface{}{
+				"header": {
+					map[string]interface{}{
+						"name":              "my_header",
+						"request_condition": "is_mobile",
+					},
+					map[string]interface{}{
+						"name":            "my_other_header",
+						"cache_condition": "is_cacheable",
+					},
+				},
+				"gzip": {
+					map[string]interface{}{
+						"name":            "my_gzip",
+						"cache_condition": "is_cacheable",
+					},
+				},
+				"s3logging": {
+					map[string]interface{}{
+						"name":               "my_s3",
+						"response_condition": "is_error",
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"is_mobile":    "header/my_header",
+				"is_cacheable": "gzip/my_gzip,header/my_other_header",
+				"is_error":     "s3logging/my_s3",
+			},
+		},
+		{
+			blocks: map[string][]interface{}{
+				"header": {
+					map[string]interface{}{
+						"name": "no_conditions",
+					},
+				},
+			},
+			expected: map[string]interface{}{},
+		},
+	}
+
+	for _, c := range cases {
+		out := buildConditionUsage(c.blocks)
+		if diff := cmp.Diff(out, c.expected); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceFastlyService() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the service",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the service",
+			},
+			"comment": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The comment on the service",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the service (vcl or wasm)",
+			},
+			"active_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The currently active version of the service",
+			},
+			"version_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions the service has",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	id := d.Get("id").(string)
+	name := d.Get("name").(string)
+
+	if id == "" && name == "" {
+		return fmt.Errorf("one of 'id' or 'name' must be given to look up a service")
+	}
+
+	var service *gofastly.Service
+	var err error
+
+	if id != "" {
+		log.Printf("[DEBUG] Reading service by ID (%s)", id)
+		service, err = conn.GetService(&gofastly.GetServiceInput{ID: id})
+		if err != nil {
+			return fmt.Errorf("error looking up service by ID (%s): %s", id, err)
+		}
+	} else {
+		log.Printf("[DEBUG] Reading service by name (%s)", name)
+		services, err := conn.ListServices(&gofastly.ListServicesInput{})
+		if err != nil {
+			return fmt.Errorf("error listing services: %s", err)
+		}
+		service, err = findServiceByName(services, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	if name != "" && service.Name != name {
+		return fmt.Errorf("service with ID (%s) has name %q, not the configured name %q", service.ID, service.Name, name)
+	}
+
+	d.SetId(service.ID)
+	d.Set("id", service.ID)
+	d.Set("name", service.Name)
+	d.Set("comment", service.Comment)
+	d.Set("type", service.Type)
+	d.Set("active_version", int(service.ActiveVersion))
+	d.Set("version_count", len(service.Versions))
+
+	return nil
+}
+
+// findServiceByName returns the service with the given name, erroring when
+// the name is missing or ambiguous. Fastly service names are not unique, so
+// a duplicate is reported rather than silently picking one.
+func findServiceByName(services []*gofastly.Service, name string) (*gofastly.Service, error) {
+	var found *gofastly.Service
+	for _, s := range services {
+		if s.Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("multiple services named %q exist; look the service up by 'id' instead", name)
+		}
+		found = s
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no service named %q was found", name)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,84 @@
+package fastly
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestFindServiceByName(t *testing.T) {
+	services := []*gofastly.Service{
+		{ID: "a1", Name: "alpha"},
+		{ID: "b1", Name: "beta"},
+		{ID: "b2", Name: "beta"},
+	}
+
+	found, err := findServiceByName(services, "alpha")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if found.ID != "a1" {
+		t.Errorf("expected service ID (a1), got (%s)", found.ID)
+	}
+
+	if _, err := findServiceByName(services, "beta"); err == nil || !strings.Contains(err.Error(), "multiple services") {
+		t.Errorf("expected ambiguity error for duplicate name, got: %v", err)
+	}
+
+	if _, err := findServiceByName(services, "gamma"); err == nil || !strings.Contains(err.Error(), "no service named") {
+		t.Errorf("expected not-found error, got: %v", err)
+	}
+}
+
+func TestAccFastlyDataSourceService_byName(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceFastlyServiceConfig(name, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.fastly_service.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"data.fastly_service.foo", "type", "vcl"),
+					resource.TestCheckResourceAttrPair(
+						"data.fastly_service.foo", "id", "fastly_service_v1.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.fastly_service.foo", "active_version", "fastly_service_v1.foo", "active_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceFastlyServiceConfig(name, domain string) string {
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-testing-domain"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+
+  force_destroy = true
+}
+
+data "fastly_service" "foo" {
+  name = "${fastly_service_v1.foo.name}"
+}
+`, name, domain)
+}
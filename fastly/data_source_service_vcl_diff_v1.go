@@ -0,0 +1,77 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceFastlyServiceVCLDiffV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceFastlyServiceVCLDiffV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the service",
+			},
+			"from_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version to diff from. Negative numbers count back from the latest version (-1 is the latest)",
+			},
+			"to_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version to diff up to. The same rules as from_version apply",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "text",
+				Description: "The format the diff is returned in (text, html or html_simple)",
+				ValidateFunc: validation.StringInSlice([]string{
+					"text",
+					"html",
+					"html_simple",
+				}, false),
+			},
+			"diff": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The diff of the generated VCL between the two versions",
+			},
+		},
+	}
+}
+
+func dataSourceFastlyServiceVCLDiffV1Read(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+	from := d.Get("from_version").(int)
+	to := d.Get("to_version").(int)
+
+	log.Printf("[DEBUG] Reading VCL diff for service (%s) from version (%d) to version (%d)", serviceID, from, to)
+	diff, err := conn.GetDiff(&gofastly.GetDiffInput{
+		Service: serviceID,
+		From:    from,
+		To:      to,
+		Format:  d.Get("format").(string),
+	})
+
+	if err != nil {
+		return fmt.Errorf("[ERR] Error looking up VCL diff for service (%s) from version (%d) to version (%d): %s", serviceID, from, to, err)
+	}
+
+	// From/To come back resolved, so negative version numbers in the config
+	// still produce a stable ID for the versions actually compared.
+	d.SetId(fmt.Sprintf("%s/%d/%d", serviceID, diff.From, diff.To))
+	d.Set("diff", diff.Diff)
+
+	return nil
+}
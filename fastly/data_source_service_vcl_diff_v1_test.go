@@ -0,0 +1,77 @@
+package fastly
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccFastlyServiceVCLDiffV1(t *testing.T) {
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	domain := fmt.Sprintf("fastly-test.tf-%s.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceVCLDiffV1Config(name, domain, false),
+			},
+			{
+				Config: testAccServiceVCLDiffV1Config(name, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.fastly_service_vcl_diff_v1.diff", "diff"),
+					resource.TestCheckResourceAttr(
+						"data.fastly_service_vcl_diff_v1.diff", "format", "text"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceVCLDiffV1Config(name, domain string, withDiff bool) string {
+	diff := ""
+	if withDiff {
+		diff = `
+data "fastly_service_vcl_diff_v1" "diff" {
+  service_id   = fastly_service_v1.foo.id
+  from_version = 1
+  to_version   = fastly_service_v1.foo.active_version
+}
+`
+	}
+
+	headers := ""
+	if withDiff {
+		headers = `
+  header {
+    destination = "http.x-diff-test"
+    type        = "request"
+    action      = "set"
+    source      = "\"diff\""
+    name        = "Diff test header"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "fastly_service_v1" "foo" {
+  name = "%s"
+
+  domain {
+    name    = "%s"
+    comment = "tf-vcl-diff-testing"
+  }
+
+  backend {
+    address = "aws.amazon.com"
+    name    = "amazon docs"
+  }
+%s
+  force_destroy = true
+}
+%s`, name, domain, headers, diff)
+}
@@ -24,13 +24,17 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"fastly_ip_ranges": dataSourceFastlyIPRanges(),
+			"fastly_ip_ranges":           dataSourceFastlyIPRanges(),
+			"fastly_service":             dataSourceFastlyService(),
+			"fastly_service_vcl_diff_v1": dataSourceFastlyServiceVCLDiffV1(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
+			"fastly_purge_key_v1":                       resourcePurgeKeyV1(),
 			"fastly_service_v1":                         resourceServiceV1(),
 			"fastly_service_acl_entries_v1":             resourceServiceAclEntriesV1(),
 			"fastly_service_dictionary_items_v1":        resourceServiceDictionaryItemsV1(),
 			"fastly_service_dynamic_snippet_content_v1": resourceServiceDynamicSnippetContentV1(),
+			"fastly_tls_private_key":                    resourceFastlyTLSPrivateKey(),
 			"fastly_user_v1":                            resourceUserV1(),
 		},
 	}
@@ -0,0 +1,114 @@
+package fastly
+
+import (
+	"log"
+	"sort"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourcePurgeKeyV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePurgeKeyV1Create,
+		Read:   resourcePurgeKeyV1Read,
+		Update: resourcePurgeKeyV1Update,
+		Delete: resourcePurgeKeyV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"service_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Service Id",
+			},
+
+			"keys": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "A set of surrogate keys to purge. The keys are purged on create and again whenever the set changes",
+			},
+
+			"hard_purge": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Issue a hard purge instead of the default soft purge. Hard purges immediately evict content rather than marking it stale",
+			},
+		},
+	}
+}
+
+func resourcePurgeKeyV1Create(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+
+	if err := purgeKeys(conn, buildPurgeKeyInputs(serviceID, d.Get("keys").(*schema.Set), d.Get("hard_purge").(bool))); err != nil {
+		return err
+	}
+
+	d.SetId(serviceID)
+	return resourcePurgeKeyV1Read(d, meta)
+}
+
+func resourcePurgeKeyV1Update(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	serviceID := d.Get("service_id").(string)
+
+	if d.HasChange("keys") {
+		if err := purgeKeys(conn, buildPurgeKeyInputs(serviceID, d.Get("keys").(*schema.Set), d.Get("hard_purge").(bool))); err != nil {
+			return err
+		}
+	}
+
+	return resourcePurgeKeyV1Read(d, meta)
+}
+
+func resourcePurgeKeyV1Read(d *schema.ResourceData, meta interface{}) error {
+	// Purges are fire-and-forget; there is no remote object to refresh.
+	return nil
+}
+
+func resourcePurgeKeyV1Delete(d *schema.ResourceData, meta interface{}) error {
+	// Purges cannot be undone. Removing from state only
+	d.SetId("")
+	return nil
+}
+
+// purgeKeys issues a purge request for each key in turn, stopping at the first
+// error.
+func purgeKeys(conn *gofastly.Client, inputs []*gofastly.PurgeKeyInput) error {
+	for _, i := range inputs {
+		log.Printf("[DEBUG] Fastly purge key opts: %#v", i)
+
+		if _, err := conn.PurgeKey(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildPurgeKeyInputs converts the configured key set into one PurgeKeyInput
+// per surrogate key. Keys are sorted so purges are issued in a stable order.
+func buildPurgeKeyInputs(serviceID string, keys *schema.Set, hardPurge bool) []*gofastly.PurgeKeyInput {
+	var ks []string
+	for _, k := range keys.List() {
+		ks = append(ks, k.(string))
+	}
+	sort.Strings(ks)
+
+	var inputs []*gofastly.PurgeKeyInput
+	for _, k := range ks {
+		inputs = append(inputs, &gofastly.PurgeKeyInput{
+			Service: serviceID,
+			Key:     k,
+			Soft:    !hardPurge,
+		})
+	}
+
+	return inputs
+}
@@ -0,0 +1,130 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceFastlyBuildPurgeKeyInputs(t *testing.T) {
+	cases := []struct {
+		serviceID string
+		keys      []interface{}
+		hardPurge bool
+		expected  []*gofastly.PurgeKeyInput
+	}{
+		{
+			serviceID: "service-id",
+			keys:      []interface{}{"homepage", "articles"},
+			hardPurge: false,
+			expected: []*gofastly.PurgeKeyInput{
+				{
+					Service: "service-id",
+					Key:     "articles",
+					Soft:    true,
+				},
+				{
+					Service: "service-id",
+					Key:     "homepage",
+					Soft:    true,
+				},
+			},
+		},
+		{
+			serviceID: "service-id",
+			keys:      []interface{}{"homepage"},
+			hardPurge: true,
+			expected: []*gofastly.PurgeKeyInput{
+				{
+					Service: "service-id",
+					Key:     "homepage",
+					Soft:    false,
+				},
+			},
+		},
+		{
+			serviceID: "service-id",
+			keys:      []interface{}{},
+			hardPurge: false,
+			expected:  nil,
+		},
+	}
+
+	for _, c := range cases {
+		keys := schema.NewSet(schema.HashString, c.keys)
+		out := buildPurgeKeyInputs(c.serviceID, keys, c.hardPurge)
+		if diff := cmp.Diff(out, c.expected); diff != "" {
+			t.Fatalf("Error matching: %s", diff)
+		}
+	}
+}
+
+func TestResourcePurgeKeyV1PurgeExecution(t *testing.T) {
+	type purgeRequest struct {
+		path string
+		soft bool
+	}
+
+	var requests []purgeRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+		requests = append(requests, purgeRequest{
+			path: r.URL.Path,
+			soft: r.Header.Get("Fastly-Soft-Purge") == "1",
+		})
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"status":"ok","id":"purge-id"}`)
+	}))
+	defer ts.Close()
+
+	conn, err := gofastly.NewClientForEndpoint("test-key", ts.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+	client := &FastlyClient{conn: conn}
+
+	// Create issues one soft purge per key, in sorted order.
+	d := schema.TestResourceDataRaw(t, resourcePurgeKeyV1().Schema, map[string]interface{}{
+		"service_id": "service-id",
+		"keys":       []interface{}{"homepage", "articles"},
+	})
+	if err := resourcePurgeKeyV1Create(d, client); err != nil {
+		t.Fatalf("create returned an error: %s", err)
+	}
+
+	expected := []purgeRequest{
+		{path: "/service/service-id/purge/articles", soft: true},
+		{path: "/service/service-id/purge/homepage", soft: true},
+	}
+	if diff := cmp.Diff(requests, expected, cmp.AllowUnexported(purgeRequest{})); diff != "" {
+		t.Fatalf("Error matching create purges: %s", diff)
+	}
+
+	// A change to keys with hard_purge set purges again without the soft
+	// purge header.
+	requests = nil
+	d = schema.TestResourceDataRaw(t, resourcePurgeKeyV1().Schema, map[string]interface{}{
+		"service_id": "service-id",
+		"keys":       []interface{}{"articles"},
+		"hard_purge": true,
+	})
+	d.SetId("service-id")
+	if err := resourcePurgeKeyV1Update(d, client); err != nil {
+		t.Fatalf("update returned an error: %s", err)
+	}
+
+	expected = []purgeRequest{
+		{path: "/service/service-id/purge/articles", soft: false},
+	}
+	if diff := cmp.Diff(requests, expected, cmp.AllowUnexported(purgeRequest{})); diff != "" {
+		t.Fatalf("Error matching update purges: %s", diff)
+	}
+}
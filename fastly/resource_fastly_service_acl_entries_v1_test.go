@@ -1,7 +1,10 @@
 package fastly
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"sort"
 	"strconv"
@@ -441,3 +444,46 @@ resource "fastly_service_v1" "foo" {
 	%s
 }`, aclName, serviceName, domainName, backendName, aclEntries)
 }
+
+func TestExecuteBatchACLOperationsChunking(t *testing.T) {
+	var requests []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("unexpected method: %s", r.Method)
+		}
+
+		var body struct {
+			Entries []*gofastly.BatchACLEntry `json:"entries"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode batch request body: %s", err)
+		}
+		requests = append(requests, len(body.Entries))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, "{}")
+	}))
+	defer ts.Close()
+
+	conn, err := gofastly.NewClientForEndpoint("test-key", ts.URL)
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	entries := make([]*gofastly.BatchACLEntry, 2500)
+	for i := range entries {
+		entries[i] = &gofastly.BatchACLEntry{
+			Operation: gofastly.CreateBatchOperation,
+			IP:        "127.0.0.1",
+		}
+	}
+
+	if err := executeBatchACLOperations(conn, "service-id", "acl-id", entries); err != nil {
+		t.Fatalf("executeBatchACLOperations returned an error: %s", err)
+	}
+
+	expected := []int{1000, 1000, 500}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("Error matching batch sizes:\nexpected: %#v\ngot: %#v", expected, requests)
+	}
+}
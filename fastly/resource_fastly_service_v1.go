@@ -38,6 +38,11 @@ var vclService = &BaseServiceDefinition{
 		NewServiceLoggingNewRelic(),
 		NewServiceLoggingKafka(),
 		NewServiceLoggingHeroku(),
+		NewServiceLoggingHoneycomb(),
+		NewServiceLoggingLogshuttle(),
+		NewServiceLoggingOpenStack(),
+		NewServiceLoggingCloudfiles(),
+		NewServiceLoggingDigitalOcean(),
 		NewServiceResponseObject(),
 		NewServiceRequestSetting(),
 		NewServiceVCL(),
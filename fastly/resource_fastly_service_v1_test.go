@@ -2,9 +2,12 @@ package fastly
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -116,6 +119,72 @@ func TestResourceFastlyFlattenBackend(t *testing.T) {
 					"ssl_ciphers":           "foo,bar,baz",
 					"shield":                "New York",
 					"weight":                100,
+
+					"ignore_ssl_port_mismatch": false,
+				},
+			},
+		},
+		// ssl_check_cert defaults to true, so an explicit false must survive
+		// the round trip or disabling cert checks causes perpetual diffs.
+		{
+			remote: []*gofastly.Backend{
+				{
+					Name:                "test.notexample.com",
+					Address:             "www.notexample.com",
+					OverrideHost:        "origin.example.com",
+					Port:                uint(443),
+					AutoLoadbalance:     false,
+					BetweenBytesTimeout: uint(10000),
+					ConnectTimeout:      uint(1000),
+					ErrorThreshold:      uint(0),
+					FirstByteTimeout:    uint(15000),
+					MaxConn:             uint(200),
+					RequestCondition:    "",
+					HealthCheck:         "",
+					UseSSL:              true,
+					SSLCheckCert:        false,
+					SSLHostname:         "",
+					SSLCACert:           "",
+					SSLCertHostname:     "",
+					SSLSNIHostname:      "",
+					SSLClientKey:        "",
+					SSLClientCert:       "",
+					MaxTLSVersion:       "",
+					MinTLSVersion:       "",
+					SSLCiphers:          nil,
+					Shield:              "",
+					Weight:              uint(100),
+				},
+			},
+			local: []map[string]interface{}{
+				{
+					"name":                  "test.notexample.com",
+					"address":               "www.notexample.com",
+					"override_host":         "origin.example.com",
+					"port":                  443,
+					"auto_loadbalance":      false,
+					"between_bytes_timeout": 10000,
+					"connect_timeout":       1000,
+					"error_threshold":       0,
+					"first_byte_timeout":    15000,
+					"max_conn":              200,
+					"request_condition":     "",
+					"healthcheck":           "",
+					"use_ssl":               true,
+					"ssl_check_cert":        false,
+					"ssl_hostname":          "",
+					"ssl_ca_cert":           "",
+					"ssl_cert_hostname":     "",
+					"ssl_sni_hostname":      "",
+					"ssl_client_key":        "",
+					"ssl_client_cert":       "",
+					"max_tls_version":       "",
+					"min_tls_version":       "",
+					"ssl_ciphers":           "",
+					"shield":                "",
+					"weight":                100,
+
+					"ignore_ssl_port_mismatch": false,
 				},
 			},
 		},
@@ -689,3 +758,95 @@ resource "fastly_service_v1" "foo" {
   force_destroy = true
 }`, name, ttl, domain, backend, backend2)
 }
+
+func TestCreateServiceComment(t *testing.T) {
+	if got := createServiceComment(""); got != "Managed by Terraform" {
+		t.Errorf("empty comment: got %q, want the create-time default", got)
+	}
+	if got := createServiceComment("demo"); got != "demo" {
+		t.Errorf("explicit comment: got %q, want %q", got, "demo")
+	}
+}
+
+func TestWaitForVersionClone(t *testing.T) {
+	var polls int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		switch {
+		case polls <= 2:
+			// The clone is not visible in the API yet.
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"msg":"Record not found"}`)
+		case polls == 3:
+			fmt.Fprint(w, `{"number":2,"service_id":"foo","locked":true}`)
+		default:
+			fmt.Fprint(w, `{"number":2,"service_id":"foo","locked":false}`)
+		}
+	}))
+	defer ts.Close()
+
+	conn, err := gofastly.NewClientForEndpoint("test-key", ts.URL)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	if err := waitForVersionClone(conn, "foo", 2, 5*time.Second, 10*time.Millisecond); err != nil {
+		t.Fatalf("Error waiting for version clone: %s", err)
+	}
+
+	if polls < 4 {
+		t.Errorf("expected at least 4 polls, got %d", polls)
+	}
+}
+
+func TestWaitForVersionCloneTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"msg":"Record not found"}`)
+	}))
+	defer ts.Close()
+
+	conn, err := gofastly.NewClientForEndpoint("test-key", ts.URL)
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	err = waitForVersionClone(conn, "foo", 2, 50*time.Millisecond, 10*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected a timeout error, got nil")
+	}
+}
+
+func TestActiveVersionDeployedAt(t *testing.T) {
+	deployed := time.Date(2020, 6, 1, 12, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		version  gofastly.Version
+		expected string
+	}{
+		{
+			name:     "active version with timestamp",
+			version:  gofastly.Version{Number: 3, UpdatedAt: &deployed},
+			expected: "2020-06-01T12:30:00Z",
+		},
+		{
+			name:     "no active version",
+			version:  gofastly.Version{Number: 0, UpdatedAt: &deployed},
+			expected: "",
+		},
+		{
+			name:     "missing timestamp",
+			version:  gofastly.Version{Number: 3},
+			expected: "",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := activeVersionDeployedAt(&c.version); got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
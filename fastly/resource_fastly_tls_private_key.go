@@ -0,0 +1,127 @@
+package fastly
+
+import (
+	"time"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceFastlyTLSPrivateKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFastlyTLSPrivateKeyCreate,
+		Read:   resourceFastlyTLSPrivateKeyRead,
+		Delete: resourceFastlyTLSPrivateKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Private keys are immutable in the Fastly API, so every argument
+		// forces a new resource rather than an update.
+		Schema: map[string]*schema.Schema{
+			"key_pem": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The contents of the private key. Must be a PEM-formatted key.",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A customizable name for the private key.",
+			},
+
+			"key_length": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The key length used to generate the private key.",
+			},
+
+			"key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The algorithm used to generate the private key. Must be RSA.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time-stamp (GMT) when the private key was created.",
+			},
+
+			"public_key_sha1": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A hash of the related public key, useful for safely identifying it.",
+			},
+
+			"replace": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Fastly recommends replacing this private key.",
+			},
+		},
+	}
+}
+
+func resourceFastlyTLSPrivateKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	key, err := conn.CreatePrivateKey(&gofastly.CreatePrivateKeyInput{
+		Key:  d.Get("key_pem").(string),
+		Name: d.Get("name").(string),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(key.ID)
+
+	return resourceFastlyTLSPrivateKeyRead(d, meta)
+}
+
+func resourceFastlyTLSPrivateKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	key, err := conn.GetPrivateKey(&gofastly.GetPrivateKeyInput{
+		ID: d.Id(),
+	})
+
+	if err != nil {
+		if errRes, ok := err.(*gofastly.HTTPError); ok && errRes.IsNotFound() {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", key.Name)
+	d.Set("key_length", key.KeyLength)
+	d.Set("key_type", key.KeyType)
+	d.Set("public_key_sha1", key.PublicKeySHA1)
+	d.Set("replace", key.Replace)
+
+	if key.CreatedAt != nil {
+		d.Set("created_at", key.CreatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceFastlyTLSPrivateKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*FastlyClient).conn
+
+	err := conn.DeletePrivateKey(&gofastly.DeletePrivateKeyInput{
+		ID: d.Id(),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package fastly
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccFastlyTLSPrivateKey_basic(t *testing.T) {
+	var privateKey gofastly.PrivateKey
+	name := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	keyPEM := generateRSAPrivateKeyPEM(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastlyTLSPrivateKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastlyTLSPrivateKeyConfig(name, keyPEM),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastlyTLSPrivateKeyExists("fastly_tls_private_key.foo", &privateKey),
+					resource.TestCheckResourceAttr(
+						"fastly_tls_private_key.foo", "name", name),
+					resource.TestCheckResourceAttr(
+						"fastly_tls_private_key.foo", "key_type", "RSA"),
+					resource.TestCheckResourceAttr(
+						"fastly_tls_private_key.foo", "key_length", "2048"),
+					resource.TestCheckResourceAttrSet(
+						"fastly_tls_private_key.foo", "created_at"),
+					resource.TestCheckResourceAttrSet(
+						"fastly_tls_private_key.foo", "public_key_sha1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFastlyTLSPrivateKeyExists(n string, privateKey *gofastly.PrivateKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Private Key ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		key, err := conn.GetPrivateKey(&gofastly.GetPrivateKeyInput{
+			ID: rs.Primary.ID,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*privateKey = *key
+
+		return nil
+	}
+}
+
+func testAccCheckFastlyTLSPrivateKeyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "fastly_tls_private_key" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*FastlyClient).conn
+		keys, err := conn.ListPrivateKeys(&gofastly.ListPrivateKeysInput{})
+		if err != nil {
+			return fmt.Errorf("[WARN] Error listing private keys when deleting private key (%s): %s", rs.Primary.ID, err)
+		}
+
+		for _, key := range keys {
+			if key.ID == rs.Primary.ID {
+				return fmt.Errorf("[WARN] Tried deleting private key (%s), but was still found", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// generateRSAPrivateKeyPEM returns a freshly generated, PEM-armored 2048 bit
+// RSA key so that tests never ship a real private key in the repository.
+func generateRSAPrivateKeyPEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating RSA key: %s", err)
+	}
+
+	var pemBuilder strings.Builder
+	if err := pem.Encode(&pemBuilder, &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}); err != nil {
+		t.Fatalf("Error encoding RSA key: %s", err)
+	}
+
+	return pemBuilder.String()
+}
+
+func testAccFastlyTLSPrivateKeyConfig(name, keyPEM string) string {
+	return fmt.Sprintf(`
+resource "fastly_tls_private_key" "foo" {
+  name    = "%s"
+  key_pem = <<KEY
+%sKEY
+}
+`, name, keyPEM)
+}
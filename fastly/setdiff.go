@@ -0,0 +1,95 @@
+package fastly
+
+import (
+	"reflect"
+)
+
+// pruneSemanticallyUnchangedElements compares the elements removed from and
+// added to a block set and drops any pair that only differs in the given
+// Computed-capable keys. Set hashes include Computed sub-fields, so a refresh
+// that fills in a server-generated value (e.g. snippet_id, or a normalised
+// header source) makes an otherwise untouched element show up in both the
+// remove and add lists. Issuing the delete/create for those elements is a
+// destructive no-op, so we filter them out before making any API calls.
+//
+// Each removed element is paired with at most one added element. The returned
+// slices contain the elements that still represent real changes.
+func pruneSemanticallyUnchangedElements(remove, add []interface{}, ignoredKeys ...string) ([]interface{}, []interface{}) {
+	matched := make([]bool, len(add))
+
+	var prunedRemove []interface{}
+	for _, oRaw := range remove {
+		of := oRaw.(map[string]interface{})
+
+		found := false
+		for i, nRaw := range add {
+			if matched[i] {
+				continue
+			}
+
+			if elementsSemanticallyEqual(of, nRaw.(map[string]interface{}), ignoredKeys) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			prunedRemove = append(prunedRemove, oRaw)
+		}
+	}
+
+	var prunedAdd []interface{}
+	for i, nRaw := range add {
+		if !matched[i] {
+			prunedAdd = append(prunedAdd, nRaw)
+		}
+	}
+
+	return prunedRemove, prunedAdd
+}
+
+// elementsSemanticallyEqual reports whether two set elements are equal once
+// the ignored keys are discounted. A string-valued ignored key is only
+// discounted when the new value is nil/empty: refreshes fill computed values
+// on the old (state) side only, so an old-set/new-empty pair is a non-change,
+// while any value in the new element — including one replacing a previously
+// empty field — still counts as a difference. A boolean-valued ignored key is
+// a config-only flag (e.g. force_destroy) that is never sent to the API, so
+// toggling it is always a state-only change.
+func elementsSemanticallyEqual(o, n map[string]interface{}, ignoredKeys []string) bool {
+	ignored := make(map[string]bool, len(ignoredKeys))
+	for _, k := range ignoredKeys {
+		ignored[k] = true
+	}
+
+	keys := make(map[string]bool, len(o)+len(n))
+	for k := range o {
+		keys[k] = true
+	}
+	for k := range n {
+		keys[k] = true
+	}
+
+	for k := range keys {
+		ov, nv := o[k], n[k]
+
+		if ignored[k] {
+			if _, isBool := ov.(bool); isBool {
+				continue
+			}
+			if _, isBool := nv.(bool); isBool {
+				continue
+			}
+			if nv == nil || nv == "" {
+				continue
+			}
+		}
+
+		if !reflect.DeepEqual(ov, nv) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,201 @@
+package fastly
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPruneSemanticallyUnchangedElements(t *testing.T) {
+	cases := []struct {
+		name           string
+		remove         []interface{}
+		add            []interface{}
+		ignoredKeys    []string
+		expectedRemove []interface{}
+		expectedAdd    []interface{}
+	}{
+		{
+			name: "computed only change is pruned",
+			remove: []interface{}{
+				map[string]interface{}{
+					"name":       "my_snippet",
+					"type":       "recv",
+					"priority":   100,
+					"snippet_id": "abc123",
+				},
+			},
+			add: []interface{}{
+				map[string]interface{}{
+					"name":       "my_snippet",
+					"type":       "recv",
+					"priority":   100,
+					"snippet_id": "",
+				},
+			},
+			ignoredKeys:    []string{"snippet_id"},
+			expectedRemove: nil,
+			expectedAdd:    nil,
+		},
+		{
+			name: "real change is kept",
+			remove: []interface{}{
+				map[string]interface{}{
+					"name":       "my_snippet",
+					"type":       "recv",
+					"priority":   100,
+					"snippet_id": "abc123",
+				},
+			},
+			add: []interface{}{
+				map[string]interface{}{
+					"name":       "my_snippet",
+					"type":       "recv",
+					"priority":   110,
+					"snippet_id": "",
+				},
+			},
+			ignoredKeys: []string{"snippet_id"},
+			expectedRemove: []interface{}{
+				map[string]interface{}{
+					"name":       "my_snippet",
+					"type":       "recv",
+					"priority":   100,
+					"snippet_id": "abc123",
+				},
+			},
+			expectedAdd: []interface{}{
+				map[string]interface{}{
+					"name":       "my_snippet",
+					"type":       "recv",
+					"priority":   110,
+					"snippet_id": "",
+				},
+			},
+		},
+		{
+			name: "explicit change to an ignored key is kept",
+			remove: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "req.http.X-Old",
+				},
+			},
+			add: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "req.http.X-New",
+				},
+			},
+			ignoredKeys: []string{"source"},
+			expectedRemove: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "req.http.X-Old",
+				},
+			},
+			expectedAdd: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "req.http.X-New",
+				},
+			},
+		},
+		{
+			name: "empty to non-empty edit of an ignored key is kept",
+			remove: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "",
+				},
+			},
+			add: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "req.http.X-New",
+				},
+			},
+			ignoredKeys: []string{"source"},
+			expectedRemove: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "",
+				},
+			},
+			expectedAdd: []interface{}{
+				map[string]interface{}{
+					"name":   "my_header",
+					"source": "req.http.X-New",
+				},
+			},
+		},
+		{
+			name: "config-only flag toggle is pruned",
+			remove: []interface{}{
+				map[string]interface{}{
+					"name":          "my_acl",
+					"acl_id":        "abc123",
+					"force_destroy": false,
+				},
+			},
+			add: []interface{}{
+				map[string]interface{}{
+					"name":          "my_acl",
+					"acl_id":        "",
+					"force_destroy": true,
+				},
+			},
+			ignoredKeys:    []string{"acl_id", "force_destroy"},
+			expectedRemove: nil,
+			expectedAdd:    nil,
+		},
+		{
+			name: "mixed elements are filtered individually",
+			remove: []interface{}{
+				map[string]interface{}{
+					"name":   "unchanged",
+					"acl_id": "abc123",
+				},
+				map[string]interface{}{
+					"name":   "removed",
+					"acl_id": "def456",
+				},
+			},
+			add: []interface{}{
+				map[string]interface{}{
+					"name":   "unchanged",
+					"acl_id": "",
+				},
+				map[string]interface{}{
+					"name":   "added",
+					"acl_id": "",
+				},
+			},
+			ignoredKeys: []string{"acl_id"},
+			expectedRemove: []interface{}{
+				map[string]interface{}{
+					"name":   "removed",
+					"acl_id": "def456",
+				},
+			},
+			expectedAdd: []interface{}{
+				map[string]interface{}{
+					"name":   "added",
+					"acl_id": "",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			remove, add := pruneSemanticallyUnchangedElements(c.remove, c.add, c.ignoredKeys...)
+			if diff := cmp.Diff(remove, c.expectedRemove); diff != "" {
+				t.Fatalf("Error matching remove: %s", diff)
+			}
+			if diff := cmp.Diff(add, c.expectedAdd); diff != "" {
+				t.Fatalf("Error matching add: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	gofastly "github.com/fastly/go-fastly/fastly"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// testSweepServicePrefix matches the names generated by the acceptance tests.
+const testSweepServicePrefix = "tf-test-"
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// sharedClientForSweepers builds a Fastly client from the same environment
+// variables the acceptance tests use.
+func sharedClientForSweepers() (*gofastly.Client, error) {
+	apiKey := os.Getenv("FASTLY_API_KEY")
+	if apiKey == "" {
+		return nil, fmt.Errorf("FASTLY_API_KEY must be set for sweepers")
+	}
+
+	url := os.Getenv("FASTLY_API_URL")
+	if url == "" {
+		url = gofastly.DefaultEndpoint
+	}
+
+	return gofastly.NewClientForEndpoint(apiKey, url)
+}
+
+func init() {
+	resource.AddTestSweepers("fastly_service_v1", &resource.Sweeper{
+		Name: "fastly_service_v1",
+		F:    testSweepServices,
+	})
+}
+
+// testSweepServices deletes services left behind by failed acceptance test
+// runs. Only services with the tf-test- prefix are touched, and active
+// versions are deactivated first, mirroring force_destroy on the resource.
+func testSweepServices(_ string) error {
+	conn, err := sharedClientForSweepers()
+	if err != nil {
+		return err
+	}
+
+	services, err := conn.ListServices(&gofastly.ListServicesInput{})
+	if err != nil {
+		return fmt.Errorf("[ERR] Error listing services during sweep: %s", err)
+	}
+
+	for _, service := range services {
+		if !strings.HasPrefix(service.Name, testSweepServicePrefix) {
+			continue
+		}
+
+		log.Printf("[INFO] Sweeping service (%s) %s", service.ID, service.Name)
+
+		if service.ActiveVersion != 0 {
+			if _, err := conn.DeactivateVersion(&gofastly.DeactivateVersionInput{
+				Service: service.ID,
+				Version: int(service.ActiveVersion),
+			}); err != nil {
+				log.Printf("[WARN] Error deactivating version (%d) of service (%s) during sweep: %s", service.ActiveVersion, service.ID, err)
+				continue
+			}
+		}
+
+		if err := conn.DeleteService(&gofastly.DeleteServiceInput{ID: service.ID}); err != nil {
+			log.Printf("[WARN] Error deleting service (%s) during sweep: %s", service.ID, err)
+		}
+	}
+
+	return nil
+}
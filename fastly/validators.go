@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -122,6 +123,64 @@ func validateUserRole() schema.SchemaValidateFunc {
 	)
 }
 
+// validateDuration checks that a string parses with time.ParseDuration.
+func validateDuration() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		if _, err := time.ParseDuration(v); err != nil {
+			errs = append(errs, fmt.Errorf("%q must be a valid duration (e.g. \"30s\"): %s", key, err))
+		}
+		return
+	}
+}
+
+// maximumVCLSizeBytes is the default Fastly account limit for uploaded VCL
+// and snippet content.
+const maximumVCLSizeBytes = 1024 * 1024
+
+// validateContentSize warns when content exceeds maxSize bytes. A warning
+// rather than an error, since the account limit can be raised by support.
+func validateContentSize(maxSize int) schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		if len(v) > maxSize {
+			warns = append(warns, fmt.Sprintf("%q is %d bytes; the default Fastly limit is %d bytes and the API may reject it", key, len(v), maxSize))
+		}
+		return
+	}
+}
+
+// validateLogFormat performs a lightweight syntax check on a logging format
+// string. It does not parse VCL; it only verifies that every %{...} escape is
+// closed and that the string does not end on a dangling %, since both mistakes
+// are only reported by the API at activation time otherwise.
+func validateLogFormat() schema.SchemaValidateFunc {
+	return func(val interface{}, key string) (warns []string, errs []error) {
+		v := val.(string)
+		for i := 0; i < len(v); i++ {
+			if v[i] != '%' {
+				continue
+			}
+			if i == len(v)-1 {
+				errs = append(errs, fmt.Errorf("%q has a dangling %% at the end of the format", key))
+				return
+			}
+			i++
+			if v[i] != '{' {
+				// %% or a single-character escape like %h.
+				continue
+			}
+			end := strings.IndexByte(v[i:], '}')
+			if end == -1 {
+				errs = append(errs, fmt.Errorf("%q has an unclosed %%{...} escape starting at position %d", key, i-1))
+				return
+			}
+			i += end
+		}
+		return
+	}
+}
+
 // TODO: Use SDK's validation.IsURLWithHTTPS() after we upgrade
 func validateHTTPSURL() schema.SchemaValidateFunc {
 	return func(val interface{}, key string) (warns []string, errs []error) {
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	gofastly "github.com/fastly/go-fastly/fastly"
@@ -362,3 +363,77 @@ func TestValidateHTTPSURL(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLogFormat(t *testing.T) {
+	for _, testcase := range []struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		{"%h %l %u %t \"%r\" %>s %b", 0, 0},
+		{"%{%Y-%m-%dT%H:%M:%S}t %{req.http.Host}V", 0, 0},
+		{"100%% organic", 0, 0},
+		{"", 0, 0},
+		{"%{req.http.Host V", 0, 1},
+		{"incomplete %", 0, 1},
+	} {
+		t.Run(testcase.value, func(t *testing.T) {
+			actualWarns, actualErrors := validateLogFormat()(testcase.value, "format")
+			if len(actualWarns) != testcase.expectedWarns {
+				t.Errorf("expected %d warnings, actual %d ", testcase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateContentSize(t *testing.T) {
+	for name, testcase := range map[string]struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		"empty":     {"", 0, 0},
+		"small":     {"sub vcl_recv { }", 0, 0},
+		"at limit":  {strings.Repeat("a", maximumVCLSizeBytes), 0, 0},
+		"oversized": {strings.Repeat("a", maximumVCLSizeBytes+1), 1, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			actualWarns, actualErrors := validateContentSize(maximumVCLSizeBytes)(testcase.value, "content")
+			if len(actualWarns) != testcase.expectedWarns {
+				t.Errorf("expected %d warnings, actual %d ", testcase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}
+
+func TestValidateDuration(t *testing.T) {
+	for _, testcase := range []struct {
+		value          string
+		expectedWarns  int
+		expectedErrors int
+	}{
+		{"30s", 0, 0},
+		{"2s", 0, 0},
+		{"1m30s", 0, 0},
+		{"500ms", 0, 0},
+		{"", 0, 1},
+		{"30", 0, 1},
+		{"ten seconds", 0, 1},
+	} {
+		t.Run(testcase.value, func(t *testing.T) {
+			actualWarns, actualErrors := validateDuration()(testcase.value, "version_clone_timeout")
+			if len(actualWarns) != testcase.expectedWarns {
+				t.Errorf("expected %d warnings, actual %d ", testcase.expectedWarns, len(actualWarns))
+			}
+			if len(actualErrors) != testcase.expectedErrors {
+				t.Errorf("expected %d errors, actual %d ", testcase.expectedErrors, len(actualErrors))
+			}
+		})
+	}
+}